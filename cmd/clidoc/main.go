@@ -103,6 +103,8 @@ func init() {
 		"NewErrorValidationPasswordMinLength":                     text.NewErrorValidationPasswordMinLength(6, 5),
 		"NewErrorValidationPasswordMaxLength":                     text.NewErrorValidationPasswordMaxLength(72, 80),
 		"NewErrorValidationPasswordTooManyBreaches":               text.NewErrorValidationPasswordTooManyBreaches(101),
+		"NewErrorValidationPasswordTooFewCharacterClasses":        text.NewErrorValidationPasswordTooFewCharacterClasses(3, 2),
+		"NewErrorValidationPasswordDisallowedWord":                text.NewErrorValidationPasswordDisallowedWord(),
 		"NewErrorValidationInvalidCredentials":                    text.NewErrorValidationInvalidCredentials(),
 		"NewErrorValidationDuplicateCredentials":                  text.NewErrorValidationDuplicateCredentials(),
 		"NewErrorValidationDuplicateCredentialsWithHints":         text.NewErrorValidationDuplicateCredentialsWithHints([]string{"{available_credential_types_list}"}, []string{"{available_oidc_providers_list}"}, "{credential_identifier_hint}"),
@@ -178,6 +180,7 @@ func init() {
 		"NewInfoSelfServiceLoginCodeMFA":                          text.NewInfoSelfServiceLoginCodeMFA(),
 		"NewInfoLoginPassword":                                    text.NewInfoLoginPassword(),
 		"NewErrorValidationAccountNotFound":                       text.NewErrorValidationAccountNotFound(),
+		"NewErrorValidationAccountLocked":                         text.NewErrorValidationAccountLocked(),
 		"NewInfoSelfServiceLoginAAL2CodeAddress":                  text.NewInfoSelfServiceLoginAAL2CodeAddress("{channel}", "{address}"),
 		"NewErrorCaptchaFailed":                                   text.NewErrorCaptchaFailed(),
 		"NewCaptchaContainerMessage":                              text.NewCaptchaContainerMessage(),
@@ -115,6 +115,8 @@ func ServePublic(r driver.Registry, cmd *cobra.Command, eg *errgroup.Group, slOp
 	})
 
 	n.UseFunc(x.CleanPath) // Prevent double slashes from breaking CSRF.
+	n.UseFunc(x.MaintenanceModeMiddleware(r, session.RouteWhoami))
+	n.UseFunc(x.ConfigHashMiddleware(r))
 	r.WithCSRFHandler(csrf)
 	n.UseHandler(http.MaxBytesHandler(r.CSRFHandler(), 5*1024*1024 /* 5 MB */))
 
@@ -325,11 +327,20 @@ func BgTasks(d driver.Registry, cmd *cobra.Command, opts []Option) error {
 	modifiers := NewOptions(cmd.Context(), opts)
 	ctx := modifiers.ctx
 
+	eg, ctx := errgroup.WithContext(ctx)
 	if d.Config().IsBackgroundCourierEnabled(ctx) {
-		return courier.Watch(ctx, d)
+		eg.Go(func() error {
+			return courier.Watch(ctx, d)
+		})
 	}
 
-	return nil
+	// Web hooks can be configured at runtime, so the worker always runs and
+	// idles while none are configured.
+	eg.Go(func() error {
+		return d.IdentityWebHookDispatcher().Work(ctx)
+	})
+
+	return eg.Wait()
 }
 
 func ServeAll(d driver.Registry, slOpts *servicelocatorx.Options, opts []Option) func(cmd *cobra.Command, args []string) error {
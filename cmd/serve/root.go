@@ -50,6 +50,13 @@ DON'T DO THIS IN PRODUCTION!
 				d.Logger().Warnf("Config version is '%s' but kratos runs on version '%s'", configVersion, config.Version)
 			}
 
+			for _, finding := range d.Config().Lint(ctx) {
+				d.Logger().
+					WithField("lint_id", finding.ID).
+					WithField("pointer", finding.Pointer).
+					Warn(finding.Message)
+			}
+
 			return daemon.ServeAll(d, sl, nil)(cmd, args)
 		},
 	}
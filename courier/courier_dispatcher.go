@@ -5,8 +5,11 @@ package courier
 
 import (
 	"context"
+	"slices"
 
 	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/courier/template"
 )
 
 func (c *courier) channels(ctx context.Context, id string) (Channel, error) {
@@ -28,6 +31,10 @@ func (c *courier) channels(ctx context.Context, id string) (Channel, error) {
 			return courierChannel, nil
 		case "http":
 			return newHttpChannel(channel.ID, channel.RequestConfig, c.deps), nil
+		case "twilio":
+			return newTwilioChannel(channel.ID, channel.TwilioConfig, c.deps), nil
+		case "vonage":
+			return newVonageChannel(channel.ID, channel.VonageConfig, c.deps), nil
 		default:
 			return nil, errors.Errorf("unknown courier channel type: %s", channel.Type)
 		}
@@ -75,6 +82,13 @@ func (c *courier) DispatchMessage(ctx context.Context, msg Message) error {
 	return nil
 }
 
+// isCategoryAllowed reports whether messages of the category may be sent.
+// Security messages are always allowed.
+func (c *courier) isCategoryAllowed(ctx context.Context, category template.Category) bool {
+	allowed := c.deps.CourierConfig().CourierAllowedCategories(ctx)
+	return category == template.CategorySecurity || len(allowed) == 0 || slices.Contains(allowed, string(category))
+}
+
 func (c *courier) DispatchQueue(ctx context.Context) error {
 	maxRetries := c.deps.CourierConfig().CourierMessageRetries(ctx)
 	pullCount := c.deps.CourierConfig().CourierWorkerPullCount(ctx)
@@ -106,6 +120,24 @@ func (c *courier) DispatchQueue(ctx context.Context) error {
 			// Skip the message
 			logger.
 				Warnf(`Message was abandoned because it did not deliver after %d attempts`, msg.SendCount)
+		} else if category := msg.TemplateType.Category(); !c.isCategoryAllowed(ctx, category) {
+			if err := c.deps.CourierPersister().SetMessageStatus(ctx, msg.ID, MessageStatusSuppressed); err != nil {
+				logger.
+					WithError(err).
+					Error(`Unable to set the message's status to "suppressed".`)
+				return err
+			}
+
+			reason := errors.Errorf(`The message was suppressed because its category "%s" is not listed in courier.allowed_categories.`, category)
+			if err := c.deps.CourierPersister().RecordDispatch(ctx, msg.ID, CourierMessageDispatchStatusFailed, reason); err != nil {
+				logger.
+					WithError(err).
+					Error(`Unable to record suppression log entry.`)
+			}
+
+			logger.
+				WithField("message_category", category).
+				Warn(`Message was suppressed because its category is not allowed.`)
 		} else if err := c.DispatchMessage(ctx, msg); err != nil {
 			logger.
 				WithError(err).
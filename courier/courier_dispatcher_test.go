@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/gofrs/uuid"
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"github.com/tidwall/gjson"
 
@@ -127,3 +128,46 @@ func TestDispatchQueue(t *testing.T) {
 	require.Contains(t, gjson.GetBytes(message.Dispatches[0].Error, "reason").String(), "failed to send email via smtp")
 	require.Contains(t, gjson.GetBytes(message.Dispatches[1].Error, "reason").String(), "failed to send email via smtp")
 }
+
+func TestDispatchQueueSuppressesDisallowedCategories(t *testing.T) {
+	ctx := context.Background()
+
+	conf, reg := internal.NewRegistryDefaultWithDSN(t, "")
+	conf.MustSet(ctx, config.ViperKeyCourierAllowedCategories, []string{string(template.CategorySecurity)})
+
+	c, err := reg.Courier(ctx)
+	require.NoError(t, err)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	suppressed := queueNewMessage(t, ctx, c, reg)
+	security, err := c.QueueEmail(ctx, templates.NewRecoveryValid(reg, &templates.RecoveryValidModel{
+		To:          "test-recipient-2@example.org",
+		RecoveryURL: "https://www.ory.sh/",
+	}))
+	require.NoError(t, err)
+	loginCode, err := c.QueueEmail(ctx, templates.NewLoginCodeValid(reg, &templates.LoginCodeValidModel{
+		To:        "test-recipient-3@example.org",
+		LoginCode: "123456",
+	}))
+	require.NoError(t, err)
+
+	// The security messages fail to deliver because there is no SMTP server,
+	// but they are attempted nonetheless.
+	require.NoError(t, c.DispatchQueue(ctx))
+
+	message, err := reg.CourierPersister().FetchMessage(ctx, suppressed)
+	require.NoError(t, err)
+	assert.Equal(t, courier.MessageStatusSuppressed, message.Status)
+	require.Len(t, message.Dispatches, 1)
+	assert.Contains(t, gjson.GetBytes(message.Dispatches[0].Error, "message").String(), `category "transactional" is not listed in courier.allowed_categories`)
+
+	for _, id := range []uuid.UUID{security, loginCode} {
+		message, err = reg.CourierPersister().FetchMessage(ctx, id)
+		require.NoError(t, err)
+		assert.Equal(t, courier.MessageStatusQueued, message.Status)
+		require.Len(t, message.Dispatches, 1)
+		assert.Contains(t, gjson.GetBytes(message.Dispatches[0].Error, "reason").String(), "failed to send email via smtp")
+	}
+}
@@ -29,6 +29,7 @@ const (
 	MessageStatusSent
 	MessageStatusProcessing
 	MessageStatusAbandoned
+	MessageStatusSuppressed
 )
 
 const (
@@ -36,6 +37,7 @@ const (
 	messageStatusSentText       = "sent"
 	messageStatusProcessingText = "processing"
 	messageStatusAbandonedText  = "abandoned"
+	messageStatusSuppressedText = "suppressed"
 )
 
 func ToMessageStatus(str string) (MessageStatus, error) {
@@ -48,6 +50,8 @@ func ToMessageStatus(str string) (MessageStatus, error) {
 		return MessageStatusProcessing, nil
 	case s.AddCase(MessageStatusAbandoned.String()):
 		return MessageStatusAbandoned, nil
+	case s.AddCase(MessageStatusSuppressed.String()):
+		return MessageStatusSuppressed, nil
 	default:
 		return 0, errors.WithStack(herodot.ErrBadRequest.WithWrap(s.ToUnknownCaseErr()).WithReason("Message status is not valid"))
 	}
@@ -63,6 +67,8 @@ func (ms MessageStatus) String() string {
 		return messageStatusProcessingText
 	case MessageStatusAbandoned:
 		return messageStatusAbandonedText
+	case MessageStatusSuppressed:
+		return messageStatusSuppressedText
 	default:
 		return ""
 	}
@@ -70,7 +76,7 @@ func (ms MessageStatus) String() string {
 
 func (ms MessageStatus) IsValid() error {
 	switch ms {
-	case MessageStatusQueued, MessageStatusSent, MessageStatusProcessing, MessageStatusAbandoned:
+	case MessageStatusQueued, MessageStatusSent, MessageStatusProcessing, MessageStatusAbandoned, MessageStatusSuppressed:
 		return nil
 	default:
 		return errors.WithStack(herodot.ErrBadRequest.WithReason("Message status is not valid"))
@@ -24,6 +24,7 @@ func TestToMessageStatus(t *testing.T) {
 			"sent":       courier.MessageStatusSent,
 			"processing": courier.MessageStatusProcessing,
 			"abandoned":  courier.MessageStatusAbandoned,
+			"suppressed": courier.MessageStatusSuppressed,
 		} {
 			result, err := courier.ToMessageStatus(str)
 			require.NoError(t, err)
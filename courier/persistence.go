@@ -5,6 +5,7 @@ package courier
 
 import (
 	"context"
+	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/pkg/errors"
@@ -36,6 +37,10 @@ type (
 		// Records an attempt of sending out a courier message
 		// Returns an error if it fails
 		RecordDispatch(ctx context.Context, msgID uuid.UUID, status CourierMessageDispatchStatus, err error) error
+
+		// DeleteExpiredMessages deletes up to limit sent or abandoned messages
+		// created before the given time.
+		DeleteExpiredMessages(ctx context.Context, before time.Time, limit int) error
 	}
 	PersistenceProvider interface {
 		CourierPersister() Persister
@@ -148,3 +148,114 @@ func TestDisallowedInternalNetwork(t *testing.T) {
 	require.Error(t, err)
 	assert.Contains(t, err.Error(), "is not a permitted destination")
 }
+
+func TestQueueSMSWithGateways(t *testing.T) {
+	ctx := context.Background()
+
+	for _, tc := range []struct {
+		gateway string
+		config  func(url string) string
+		assert  func(t *testing.T, r *http.Request) (to, body string)
+		respond string
+	}{
+		{
+			gateway: "twilio",
+			config: func(url string) string {
+				return fmt.Sprintf(`{"account_sid":"AC123","auth_token":"secret","from":"+12065550100","api_url":"%s"}`, url)
+			},
+			assert: func(t *testing.T, r *http.Request) (string, string) {
+				assert.Equal(t, "/2010-04-01/Accounts/AC123/Messages.json", r.URL.Path)
+				user, password, ok := r.BasicAuth()
+				require.True(t, ok)
+				assert.Equal(t, "AC123", user)
+				assert.Equal(t, "secret", password)
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "+12065550100", r.PostForm.Get("From"))
+				return r.PostForm.Get("To"), r.PostForm.Get("Body")
+			},
+			respond: `{"sid":"SM123"}`,
+		},
+		{
+			gateway: "vonage",
+			config: func(url string) string {
+				return fmt.Sprintf(`{"api_key":"key","api_secret":"secret","from":"Acme","api_url":"%s"}`, url)
+			},
+			assert: func(t *testing.T, r *http.Request) (string, string) {
+				assert.Equal(t, "/sms/json", r.URL.Path)
+				require.NoError(t, r.ParseForm())
+				assert.Equal(t, "key", r.PostForm.Get("api_key"))
+				assert.Equal(t, "secret", r.PostForm.Get("api_secret"))
+				assert.Equal(t, "Acme", r.PostForm.Get("from"))
+				return "+" + r.PostForm.Get("to"), r.PostForm.Get("text")
+			},
+			respond: `{"message-count":"1","messages":[{"status":"0"}]}`,
+		},
+	} {
+		t.Run("gateway="+tc.gateway, func(t *testing.T) {
+			actual := make(chan *sms.TestStubModel, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				to, body := tc.assert(t, r)
+				actual <- &sms.TestStubModel{To: to, Body: body}
+				_, _ = w.Write([]byte(tc.respond))
+			}))
+			t.Cleanup(srv.Close)
+
+			conf, reg := internal.NewFastRegistryWithMocks(t)
+			conf.MustSet(ctx, config.ViperKeyCourierChannels, fmt.Sprintf(`[
+				{
+					"id": "sms",
+					"type": "%[1]s",
+					"%[1]s_config": %[2]s
+				}
+			]`, tc.gateway, tc.config(srv.URL)))
+			conf.MustSet(ctx, config.ViperKeyCourierSMTPURL, "http://foo.url")
+
+			c, err := reg.Courier(ctx)
+			require.NoError(t, err)
+			c.(interface {
+				FailOnDispatchError()
+			}).FailOnDispatchError()
+
+			_, err = c.QueueSMS(ctx, sms.NewTestStub(reg, &sms.TestStubModel{To: "+12065550101", Body: "test-sms-body"}))
+			require.NoError(t, err)
+			require.NoError(t, c.DispatchQueue(ctx))
+
+			select {
+			case message := <-actual:
+				assert.Equal(t, "+12065550101", message.To)
+				assert.Equal(t, "stub sms body test-sms-body\n", message.Body)
+			case <-time.After(10 * time.Second):
+				t.Fatal("the gateway was not called")
+			}
+		})
+	}
+
+	t.Run("case=vonage reports a failed message", func(t *testing.T) {
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{"message-count":"1","messages":[{"status":"2","error-text":"Missing to param"}]}`))
+		}))
+		t.Cleanup(srv.Close)
+
+		conf, reg := internal.NewFastRegistryWithMocks(t)
+		conf.MustSet(ctx, config.ViperKeyCourierChannels, fmt.Sprintf(`[
+			{
+				"id": "sms",
+				"type": "vonage",
+				"vonage_config": {"api_key":"key","api_secret":"secret","from":"Acme","api_url":"%s"}
+			}
+		]`, srv.URL))
+		conf.MustSet(ctx, config.ViperKeyCourierSMTPURL, "http://foo.url")
+
+		c, err := reg.Courier(ctx)
+		require.NoError(t, err)
+		c.(interface {
+			FailOnDispatchError()
+		}).FailOnDispatchError()
+
+		_, err = c.QueueSMS(ctx, sms.NewTestStub(reg, &sms.TestStubModel{To: "+12065550101", Body: "test-sms-body"}))
+		require.NoError(t, err)
+		err = c.DispatchQueue(ctx)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "Missing to param")
+	})
+}
@@ -21,3 +21,26 @@ const (
 	TypeLoginCodeValid          TemplateType = "login_code_valid"
 	TypeRegistrationCodeValid   TemplateType = "registration_code_valid"
 )
+
+// A Template's category
+//
+// Messages of a category can be suppressed with `courier.allowed_categories`,
+// except for security messages.
+type Category string
+
+const (
+	CategorySecurity      Category = "security"
+	CategoryTransactional Category = "transactional"
+)
+
+// Category returns the category of messages rendered from this template type.
+func (t TemplateType) Category() Category {
+	switch t {
+	case TypeRecoveryInvalid, TypeRecoveryValid, TypeRecoveryCodeInvalid, TypeRecoveryCodeValid,
+		TypeVerificationInvalid, TypeVerificationValid, TypeVerificationCodeInvalid, TypeVerificationCodeValid,
+		TypeLoginCodeValid, TypeRegistrationCodeValid:
+		return CategorySecurity
+	default:
+		return CategoryTransactional
+	}
+}
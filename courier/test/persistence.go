@@ -286,5 +286,42 @@ func TestPersister(ctx context.Context, newNetworkUnlessExisting NetworkWrapper,
 				require.ErrorIs(t, err, sqlcon.ErrNoRows)
 			})
 		})
+
+		t.Run("case=DeleteExpiredMessages", func(t *testing.T) {
+			now := time.Now().UTC().Truncate(time.Second)
+
+			add := func(t *testing.T, p PersisterWrapper, createdAt time.Time, status courier.MessageStatus) uuid.UUID {
+				var m courier.Message
+				require.NoError(t, faker.FakeData(&m))
+				require.NoError(t, p.AddMessage(ctx, &m))
+				require.NoError(t, p.SetMessageStatus(ctx, m.ID, status))
+				require.NoError(t, p.GetConnection(ctx).RawQuery("UPDATE courier_messages SET created_at = ? WHERE id = ? AND nid = ?", createdAt, m.ID, m.NID).Exec())
+				return m.ID
+			}
+
+			oldSent := add(t, p, now.Add(-48*time.Hour), courier.MessageStatusSent)
+			oldAbandoned := add(t, p, now.Add(-48*time.Hour), courier.MessageStatusAbandoned)
+			oldQueued := add(t, p, now.Add(-48*time.Hour), courier.MessageStatusQueued)
+			recentSent := add(t, p, now, courier.MessageStatusSent)
+
+			_, otherP := newNetwork(t, ctx)
+			otherSent := add(t, otherP, now.Add(-48*time.Hour), courier.MessageStatusSent)
+
+			require.NoError(t, p.DeleteExpiredMessages(ctx, now.Add(-24*time.Hour), 100))
+
+			for _, id := range []uuid.UUID{oldSent, oldAbandoned} {
+				_, err := p.FetchMessage(ctx, id)
+				assert.ErrorIs(t, err, sqlcon.ErrNoRows)
+			}
+			for _, id := range []uuid.UUID{oldQueued, recentSent} {
+				_, err := p.FetchMessage(ctx, id)
+				assert.NoError(t, err)
+			}
+
+			t.Run("does not delete on another network", func(t *testing.T) {
+				_, err := otherP.FetchMessage(ctx, otherSent)
+				assert.NoError(t, err)
+			})
+		})
 	}
 }
@@ -0,0 +1,85 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package courier
+
+import (
+	"context"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/x/otelx"
+)
+
+const defaultTwilioAPIURL = "https://api.twilio.com"
+
+// twilioChannel sends SMS through the Twilio Messaging API.
+type twilioChannel struct {
+	id string
+	c  *config.TwilioConfig
+	d  channelDependencies
+}
+
+var _ Channel = new(twilioChannel)
+
+func newTwilioChannel(id string, c *config.TwilioConfig, d channelDependencies) *twilioChannel {
+	return &twilioChannel{id: id, c: c, d: d}
+}
+
+func (c *twilioChannel) ID() string {
+	return c.id
+}
+
+func (c *twilioChannel) Dispatch(ctx context.Context, msg Message) (err error) {
+	ctx, span := c.d.Tracer(ctx).Tracer().Start(ctx, "courier.twilioChannel.Dispatch")
+	defer otelx.End(span, &err)
+
+	if c.c == nil {
+		return errors.Errorf("courier channel %s is missing its twilio_config", c.id)
+	}
+
+	form := url.Values{"To": {msg.Recipient}, "Body": {msg.Body}}
+	if strings.HasPrefix(c.c.From, "MG") {
+		form.Set("MessagingServiceSid", c.c.From)
+	} else {
+		form.Set("From", c.c.From)
+	}
+
+	apiURL := c.c.APIURL
+	if apiURL == "" {
+		apiURL = defaultTwilioAPIURL
+	}
+	req, err := retryablehttp.NewRequestWithContext(ctx, "POST",
+		strings.TrimSuffix(apiURL, "/")+"/2010-04-01/Accounts/"+url.PathEscape(c.c.AccountSID)+"/Messages.json",
+		strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.SetBasicAuth(c.c.AccountSID, c.c.AuthToken)
+
+	res, err := c.d.HTTPClient(ctx).Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	logger := c.d.Logger().
+		WithField("message_id", msg.ID).
+		WithField("message_nid", msg.NID).
+		WithField("message_type", msg.Type).
+		WithField("message_template_type", msg.TemplateType)
+
+	if res.StatusCode >= 200 && res.StatusCode < 300 {
+		logger.Debug("Courier sent out SMS via Twilio.")
+		return nil
+	}
+
+	err = errors.Errorf("unable to dispatch SMS because Twilio replied with status code %d", res.StatusCode)
+	logger.WithError(err).Error("Sending SMS via Twilio failed.")
+	return err
+}
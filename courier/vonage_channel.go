@@ -0,0 +1,107 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package courier
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/x/otelx"
+)
+
+const defaultVonageAPIURL = "https://rest.nexmo.com"
+
+// vonageChannel sends SMS through the Vonage SMS API.
+type vonageChannel struct {
+	id string
+	c  *config.VonageConfig
+	d  channelDependencies
+}
+
+var _ Channel = new(vonageChannel)
+
+func newVonageChannel(id string, c *config.VonageConfig, d channelDependencies) *vonageChannel {
+	return &vonageChannel{id: id, c: c, d: d}
+}
+
+func (c *vonageChannel) ID() string {
+	return c.id
+}
+
+// vonageResponse is the relevant part of a Vonage SMS API response. The API
+// replies with 200 OK and reports failures per message.
+type vonageResponse struct {
+	Messages []struct {
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"`
+	} `json:"messages"`
+}
+
+func (c *vonageChannel) Dispatch(ctx context.Context, msg Message) (err error) {
+	ctx, span := c.d.Tracer(ctx).Tracer().Start(ctx, "courier.vonageChannel.Dispatch")
+	defer otelx.End(span, &err)
+
+	if c.c == nil {
+		return errors.Errorf("courier channel %s is missing its vonage_config", c.id)
+	}
+
+	form := url.Values{
+		"api_key":    {c.c.APIKey},
+		"api_secret": {c.c.APISecret},
+		"from":       {c.c.From},
+		// Vonage expects the number without the leading plus.
+		"to":   {strings.TrimPrefix(msg.Recipient, "+")},
+		"text": {msg.Body},
+		"type": {"unicode"},
+	}
+
+	apiURL := c.c.APIURL
+	if apiURL == "" {
+		apiURL = defaultVonageAPIURL
+	}
+	req, err := retryablehttp.NewRequestWithContext(ctx, "POST", strings.TrimSuffix(apiURL, "/")+"/sms/json", strings.NewReader(form.Encode()))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	res, err := c.d.HTTPClient(ctx).Do(req)
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	logger := c.d.Logger().
+		WithField("message_id", msg.ID).
+		WithField("message_nid", msg.NID).
+		WithField("message_type", msg.Type).
+		WithField("message_template_type", msg.TemplateType)
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		err = errors.Errorf("unable to dispatch SMS because Vonage replied with status code %d", res.StatusCode)
+		logger.WithError(err).Error("Sending SMS via Vonage failed.")
+		return err
+	}
+
+	var body vonageResponse
+	if err := json.NewDecoder(res.Body).Decode(&body); err != nil {
+		return errors.WithStack(err)
+	}
+	for _, m := range body.Messages {
+		if m.Status != "0" {
+			err = errors.Errorf("unable to dispatch SMS because Vonage replied with status %s: %s", m.Status, m.ErrorText)
+			logger.WithError(err).Error("Sending SMS via Vonage failed.")
+			return err
+		}
+	}
+
+	logger.Debug("Courier sent out SMS via Vonage.")
+	return nil
+}
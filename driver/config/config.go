@@ -6,15 +6,20 @@ package config
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"io"
+	"net"
 	"net/http"
 	"net/url"
 	"os"
 	"runtime"
+	"strconv"
 	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -36,6 +41,7 @@ import (
 	"github.com/ory/jsonschema/v3"
 	"github.com/ory/jsonschema/v3/httploader"
 	"github.com/ory/kratos/embedx"
+	"github.com/ory/kratos/text"
 	"github.com/ory/x/configx"
 	"github.com/ory/x/contextx"
 	"github.com/ory/x/httpx"
@@ -80,6 +86,8 @@ const (
 	ViperKeyCourierSMTPHeaders                               = "courier.smtp.headers"
 	ViperKeyCourierSMTPLocalName                             = "courier.smtp.local_name"
 	ViperKeyCourierMessageRetries                            = "courier.message_retries"
+	ViperKeyCourierMessageTTL                                = "courier.message_ttl"
+	ViperKeyCourierAllowedCategories                         = "courier.allowed_categories"
 	ViperKeyCourierWorkerPullCount                           = "courier.worker.pull_count"
 	ViperKeyCourierWorkerPullWait                            = "courier.worker.pull_wait"
 	ViperKeyCourierChannels                                  = "courier.channels"
@@ -87,6 +95,10 @@ const (
 	ViperKeySecretsCookie                                    = "secrets.cookie"
 	ViperKeySecretsCipher                                    = "secrets.cipher"
 	ViperKeyDisablePublicHealthRequestLog                    = "serve.public.request_log.disable_for_health"
+	ViperKeyPublicExposeConfigHash                           = "serve.public.expose_config_hash"
+	ViperKeyPublicClientIPHeader                             = "serve.public.client_ip.header"
+	ViperKeyPublicClientIPTrustedHops                        = "serve.public.client_ip.trusted_hops"
+	ViperKeyPublicClientIPTrustedCIDRs                       = "serve.public.client_ip.trusted_cidrs"
 	ViperKeyPublicBaseURL                                    = "serve.public.base_url"
 	ViperKeyPublicPort                                       = "serve.public.port"
 	ViperKeyPublicHost                                       = "serve.public.host"
@@ -109,6 +121,7 @@ const (
 	ViperKeyAdminTLSCertPath                                 = "serve.admin.tls.cert.path"
 	ViperKeyAdminTLSKeyPath                                  = "serve.admin.tls.key.path"
 	ViperKeySessionLifespan                                  = "session.lifespan"
+	ViperKeySessionImpersonationLifespan                     = "session.impersonation.lifespan"
 	ViperKeySessionSameSite                                  = "session.cookie.same_site"
 	ViperKeySessionDomain                                    = "session.cookie.domain"
 	ViperKeySessionName                                      = "session.cookie.name"
@@ -129,6 +142,7 @@ const (
 	ViperKeyURLsAllowedReturnToDomains                       = "selfservice.allowed_return_urls"
 	ViperKeySelfServiceRegistrationEnabled                   = "selfservice.flows.registration.enabled"
 	ViperKeySelfServiceRegistrationLoginHints                = "selfservice.flows.registration.login_hints"
+	ViperKeySelfServiceRegistrationMaxIdentities             = "selfservice.flows.registration.max_identities"
 	ViperKeySelfServiceRegistrationEnableLegacyOneStep       = "selfservice.flows.registration.enable_legacy_one_step"
 	ViperKeySelfServiceRegistrationUI                        = "selfservice.flows.registration.ui_url"
 	ViperKeySelfServiceRegistrationRequestLifespan           = "selfservice.flows.registration.lifespan"
@@ -166,6 +180,11 @@ const (
 	ViperKeySelfServiceVerificationNotifyUnknownRecipients   = "selfservice.flows.verification.notify_unknown_recipients"
 	ViperKeyDefaultIdentitySchemaID                          = "identity.default_schema_id"
 	ViperKeyIdentitySchemas                                  = "identity.schemas"
+	ViperKeyIdentityDefaultMetadataPublic                    = "identity.default_metadata_public"
+	ViperKeyIdentityDefaultMetadataAdmin                     = "identity.default_metadata_admin"
+	ViperKeyIdentityWebHooks                                 = "identity.webhooks"
+	ViperKeyIdentitySchemaCacheTTL                           = "identity.schema_cache.ttl"
+	ViperKeyIdentitySchemaCacheStaleWhileRevalidate          = "identity.schema_cache.stale_while_revalidate"
 	ViperKeyHasherAlgorithm                                  = "hashers.algorithm"
 	ViperKeyHasherArgon2ConfigMemory                         = "hashers.argon2.memory"
 	ViperKeyHasherArgon2ConfigIterations                     = "hashers.argon2.iterations"
@@ -183,12 +202,18 @@ const (
 	ViperKeyLinkBaseURL                                      = "selfservice.methods.link.config.base_url"
 	ViperKeyCodeLifespan                                     = "selfservice.methods.code.config.lifespan"
 	ViperKeyCodeConfigMissingCredentialFallbackEnabled       = "selfservice.methods.code.config.missing_credential_fallback_enabled"
+	ViperKeyCodeMaxSubmissions                               = "selfservice.methods.code.config.max_submissions"
 	ViperKeyPasswordHaveIBeenPwnedHost                       = "selfservice.methods.password.config.haveibeenpwned_host"
 	ViperKeyPasswordHaveIBeenPwnedEnabled                    = "selfservice.methods.password.config.haveibeenpwned_enabled"
 	ViperKeyPasswordMaxBreaches                              = "selfservice.methods.password.config.max_breaches"
 	ViperKeyPasswordMinLength                                = "selfservice.methods.password.config.min_password_length"
 	ViperKeyPasswordIdentifierSimilarityCheckEnabled         = "selfservice.methods.password.config.identifier_similarity_check_enabled"
+	ViperKeyPasswordMinCharacterClasses                      = "selfservice.methods.password.config.min_character_classes"
+	ViperKeyPasswordDisallowedWords                          = "selfservice.methods.password.config.disallowed_words"
 	ViperKeyIgnoreNetworkErrors                              = "selfservice.methods.password.config.ignore_network_errors"
+	ViperKeyPasswordMaxAttempts                              = "selfservice.methods.password.config.max_attempts"
+	ViperKeyPasswordLockoutDuration                          = "selfservice.methods.password.config.lockout_duration"
+	ViperKeyPasswordRevealLockout                            = "selfservice.methods.password.config.reveal_lockout"
 	ViperKeyTOTPIssuer                                       = "selfservice.methods.totp.config.issuer"
 	ViperKeyOIDCBaseRedirectURL                              = "selfservice.methods.oidc.config.base_redirect_uri"
 	ViperKeyWebAuthnRPDisplayName                            = "selfservice.methods.webauthn.config.rp.display_name"
@@ -208,6 +233,16 @@ const (
 	ViperKeyPreviewDefaultReadConsistencyLevel               = "preview.default_read_consistency_level"
 	ViperKeyVersion                                          = "version"
 	ViperKeyPasswordMigrationHook                            = "selfservice.methods.password.config.migrate_hook"
+	ViperKeyJsonnetTimeout                                   = "jsonnet.timeout"
+	ViperKeyJsonnetMaxOutputSize                             = "jsonnet.max_output_size"
+	ViperKeyUIMessageOverrides                               = "ui.message_overrides"
+	ViperKeyErrorsIncludeDebug                               = "errors.include_debug"
+	ViperKeyErrorsIDPrefix                                   = "errors.id_prefix"
+	ViperKeyMaintenanceEnabled                               = "maintenance.enabled"
+	ViperKeyMaintenanceMessage                               = "maintenance.message"
+	ViperKeyMaintenanceRetryAfterSeconds                     = "maintenance.retry_after_seconds"
+	ViperKeyMaintenanceAllowWhoami                           = "maintenance.allow_whoami"
+	ViperKeyTenantMeta                                       = "x-tenant-meta"
 )
 
 const (
@@ -257,12 +292,46 @@ type (
 		URL string `json:"url" koanf:"url"`
 	}
 	PasswordPolicy struct {
-		HaveIBeenPwnedHost               string `json:"haveibeenpwned_host"`
-		HaveIBeenPwnedEnabled            bool   `json:"haveibeenpwned_enabled"`
-		MaxBreaches                      uint   `json:"max_breaches"`
-		IgnoreNetworkErrors              bool   `json:"ignore_network_errors"`
-		MinPasswordLength                uint   `json:"min_password_length"`
-		IdentifierSimilarityCheckEnabled bool   `json:"identifier_similarity_check_enabled"`
+		HaveIBeenPwnedHost               string   `json:"haveibeenpwned_host"`
+		HaveIBeenPwnedEnabled            bool     `json:"haveibeenpwned_enabled"`
+		MaxBreaches                      uint     `json:"max_breaches"`
+		IgnoreNetworkErrors              bool     `json:"ignore_network_errors"`
+		MinPasswordLength                uint     `json:"min_password_length"`
+		IdentifierSimilarityCheckEnabled bool     `json:"identifier_similarity_check_enabled"`
+		MinCharacterClasses              uint     `json:"min_character_classes"`
+		DisallowedWords                  []string `json:"disallowed_words"`
+	}
+	PasswordLockout struct {
+		MaxAttempts     int           `json:"max_attempts"`
+		LockoutDuration time.Duration `json:"lockout_duration"`
+		RevealLockout   bool          `json:"reveal_lockout"`
+	}
+	ClientIP struct {
+		// Header is the request header carrying the client IP. If empty, the
+		// well-known proxy headers are used without any trust check.
+		Header       string
+		TrustedHops  int
+		TrustedCIDRs []*net.IPNet
+	}
+	Maintenance struct {
+		Enabled           bool   `json:"enabled"`
+		Message           string `json:"message"`
+		RetryAfterSeconds int    `json:"retry_after_seconds"`
+		AllowWhoami       bool   `json:"allow_whoami"`
+	}
+	IdentitySchemaCache struct {
+		// TTL is how long a fetched remote schema is used without revalidation.
+		// Zero disables the cache.
+		TTL time.Duration
+		// StaleWhileRevalidate is how long after the TTL expired the cached
+		// copy is still served while it is revalidated in the background.
+		StaleWhileRevalidate time.Duration
+	}
+	IdentityWebHook struct {
+		ID               string          `json:"id" koanf:"id"`
+		Events           []string        `json:"events" koanf:"events"`
+		RequestConfig    json.RawMessage `json:"request_config" koanf:"-"`
+		RequestConfigRaw map[string]any  `json:"-" koanf:"request_config"`
 	}
 	Schemas                  []Schema
 	CourierEmailBodyTemplate struct {
@@ -283,9 +352,24 @@ type (
 		ID               string          `json:"id" koanf:"id"`
 		Type             string          `json:"type" koanf:"type"`
 		SMTPConfig       *SMTPConfig     `json:"smtp_config" koanf:"smtp_config"`
+		TwilioConfig     *TwilioConfig   `json:"twilio_config" koanf:"twilio_config"`
+		VonageConfig     *VonageConfig   `json:"vonage_config" koanf:"vonage_config"`
 		RequestConfig    json.RawMessage `json:"request_config" koanf:"-"`
 		RequestConfigRaw map[string]any  `json:"-" koanf:"request_config"`
 	}
+	TwilioConfig struct {
+		AccountSID string `json:"account_sid" koanf:"account_sid"`
+		AuthToken  string `json:"auth_token" koanf:"auth_token"`
+		// From is the sender phone number or a messaging service SID.
+		From   string `json:"from" koanf:"from"`
+		APIURL string `json:"api_url" koanf:"api_url"`
+	}
+	VonageConfig struct {
+		APIKey    string `json:"api_key" koanf:"api_key"`
+		APISecret string `json:"api_secret" koanf:"api_secret"`
+		From      string `json:"from" koanf:"from"`
+		APIURL    string `json:"api_url" koanf:"api_url"`
+	}
 	SMTPConfig struct {
 		ConnectionURI  string            `json:"connection_uri" koanf:"connection_uri"`
 		ClientCertPath string            `json:"client_cert_path" koanf:"client_cert_path"`
@@ -305,6 +389,15 @@ type (
 		c                  contextx.Contextualizer
 		identityMetaSchema *jsonschema.Schema
 		stdOutOrErr        io.Writer
+		contentHash        atomic.Pointer[revisioned[string]]
+		clientIP           atomic.Pointer[revisioned[*ClientIP]]
+	}
+	// revisioned caches a value derived from one config revision. Every reload
+	// and Set replaces the koanf instance of the provider, which makes it a
+	// revision key.
+	revisioned[T any] struct {
+		revision any
+		value    T
 	}
 	Provider interface {
 		Config() *Config
@@ -325,6 +418,8 @@ type (
 		CourierSMSTemplatesLoginCodeValid(ctx context.Context) *CourierSMSTemplate
 		CourierSMSTemplatesRegistrationCodeValid(ctx context.Context) *CourierSMSTemplate
 		CourierMessageRetries(ctx context.Context) int
+		CourierMessageTTL(ctx context.Context) time.Duration
+		CourierAllowedCategories(ctx context.Context) []string
 		CourierWorkerPullCount(ctx context.Context) int
 		CourierWorkerPullWait(ctx context.Context) time.Duration
 		CourierChannels(context.Context) ([]*CourierChannel, error)
@@ -603,6 +698,44 @@ func (p *Config) DefaultIdentityTraitsSchemaID(ctx context.Context) string {
 	return p.GetProvider(ctx).String(ViperKeyDefaultIdentitySchemaID)
 }
 
+// IdentityDefaultMetadataPublic returns the public metadata merged into every
+// new identity, or nil if none is configured.
+func (p *Config) IdentityDefaultMetadataPublic(ctx context.Context) map[string]any {
+	m, _ := p.GetProvider(ctx).Get(ViperKeyIdentityDefaultMetadataPublic).(map[string]any)
+	return m
+}
+
+// IdentityDefaultMetadataAdmin returns the admin metadata merged into every
+// new identity, or nil if none is configured.
+func (p *Config) IdentityDefaultMetadataAdmin(ctx context.Context) map[string]any {
+	m, _ := p.GetProvider(ctx).Get(ViperKeyIdentityDefaultMetadataAdmin).(map[string]any)
+	return m
+}
+
+// IdentityWebHooks returns the web hooks notified about identity lifecycle
+// events.
+func (p *Config) IdentityWebHooks(ctx context.Context) (hooks []*IdentityWebHook, _ error) {
+	if err := p.GetProvider(ctx).Koanf.Unmarshal(ViperKeyIdentityWebHooks, &hooks); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	for _, h := range hooks {
+		var err error
+		h.RequestConfig, err = json.Marshal(h.RequestConfigRaw)
+		if err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+	return hooks, nil
+}
+
+func (p *Config) IdentitySchemaCache(ctx context.Context) IdentitySchemaCache {
+	pp := p.GetProvider(ctx)
+	return IdentitySchemaCache{
+		TTL:                  pp.DurationF(ViperKeyIdentitySchemaCacheTTL, 0),
+		StaleWhileRevalidate: pp.DurationF(ViperKeyIdentitySchemaCacheStaleWhileRevalidate, 0),
+	}
+}
+
 func (p *Config) TOTPIssuer(ctx context.Context) string {
 	return p.GetProvider(ctx).StringF(ViperKeyTOTPIssuer, p.SelfPublicURL(ctx).Hostname())
 }
@@ -685,6 +818,12 @@ func (p *Config) SelfServiceFlowRegistrationLoginHints(ctx context.Context) bool
 	return p.GetProvider(ctx).Bool(ViperKeySelfServiceRegistrationLoginHints)
 }
 
+// SelfServiceFlowRegistrationMaxIdentities returns the number of identities
+// after which registration is rejected. Zero means no limit.
+func (p *Config) SelfServiceFlowRegistrationMaxIdentities(ctx context.Context) int64 {
+	return int64(p.GetProvider(ctx).IntF(ViperKeySelfServiceRegistrationMaxIdentities, 0))
+}
+
 func (p *Config) SelfServiceFlowRegistrationTwoSteps(ctx context.Context) bool {
 	return !p.GetProvider(ctx).BoolF(ViperKeySelfServiceRegistrationEnableLegacyOneStep, false)
 }
@@ -932,6 +1071,52 @@ func (p *Config) DisablePublicHealthRequestLog(ctx context.Context) bool {
 	return p.GetProvider(ctx).Bool(ViperKeyDisablePublicHealthRequestLog)
 }
 
+func (p *Config) PublicExposeConfigHash(ctx context.Context) bool {
+	return p.GetProvider(ctx).Bool(ViperKeyPublicExposeConfigHash)
+}
+
+// ConfigContentHash returns a short hash of the effective configuration. It only
+// depends on the config content, so replicas serving the same config return the
+// same value. The hash is computed once per config revision.
+func (p *Config) ConfigContentHash(ctx context.Context) (string, error) {
+	provider := p.GetProvider(ctx)
+	revision := any(provider.Koanf)
+	if cached := p.contentHash.Load(); cached != nil && cached.revision == revision {
+		return cached.value, nil
+	}
+
+	raw, err := json.Marshal(provider.All())
+	if err != nil {
+		return "", errors.WithStack(err)
+	}
+	sum := sha256.Sum256(raw)
+	hash := hex.EncodeToString(sum[:8])
+	p.contentHash.Store(&revisioned[string]{revision: revision, value: hash})
+	return hash, nil
+}
+
+// PublicClientIP returns the client IP settings. The trusted CIDRs are
+// validated by the config schema and parsed once per config revision.
+func (p *Config) PublicClientIP(ctx context.Context) *ClientIP {
+	provider := p.GetProvider(ctx)
+	revision := any(provider.Koanf)
+	if cached := p.clientIP.Load(); cached != nil && cached.revision == revision {
+		return cached.value
+	}
+
+	c := &ClientIP{
+		Header:      provider.String(ViperKeyPublicClientIPHeader),
+		TrustedHops: provider.IntF(ViperKeyPublicClientIPTrustedHops, 1),
+	}
+	for _, raw := range provider.Strings(ViperKeyPublicClientIPTrustedCIDRs) {
+		if _, cidr, err := net.ParseCIDR(raw); err == nil {
+			c.TrustedCIDRs = append(c.TrustedCIDRs, cidr)
+		}
+	}
+	p.clientIP.Store(&revisioned[*ClientIP]{revision: revision, value: c})
+	return c
+}
+
 func (p *Config) SelfPublicURL(ctx context.Context) *url.URL {
 	return p.baseURL(ctx, ViperKeyPublicBaseURL, ViperKeyPublicHost, ViperKeyPublicPort, 4433)
 }
@@ -1004,6 +1189,11 @@ func (p *Config) SessionLifespan(ctx context.Context) time.Duration {
 	return p.GetProvider(ctx).DurationF(ViperKeySessionLifespan, time.Hour*24)
 }
 
+// SessionImpersonationLifespan returns time.Hour when the value is not set.
+func (p *Config) SessionImpersonationLifespan(ctx context.Context) time.Duration {
+	return p.GetProvider(ctx).DurationF(ViperKeySessionImpersonationLifespan, time.Hour)
+}
+
 func (p *Config) SessionPersistentCookie(ctx context.Context) bool {
 	return p.GetProvider(ctx).Bool(ViperKeySessionPersistentCookie)
 }
@@ -1183,6 +1373,18 @@ func (p *Config) CourierMessageRetries(ctx context.Context) int {
 	return p.GetProvider(ctx).IntF(ViperKeyCourierMessageRetries, 5)
 }
 
+// CourierMessageTTL returns how long sent and abandoned messages are kept
+// before the database cleanup removes them. Zero keeps them forever.
+func (p *Config) CourierMessageTTL(ctx context.Context) time.Duration {
+	return p.GetProvider(ctx).DurationF(ViperKeyCourierMessageTTL, 0)
+}
+
+// CourierAllowedCategories returns the message categories the courier may
+// send. An empty list allows all categories.
+func (p *Config) CourierAllowedCategories(ctx context.Context) []string {
+	return p.GetProvider(ctx).Strings(ViperKeyCourierAllowedCategories)
+}
+
 func (p *Config) CourierWorkerPullCount(ctx context.Context) int {
 	return p.GetProvider(ctx).Int(ViperKeyCourierWorkerPullCount)
 }
@@ -1346,6 +1548,10 @@ func (p *Config) SelfServiceCodeMethodMissingCredentialFallbackEnabled(ctx conte
 	return p.GetProvider(ctx).Bool(ViperKeyCodeConfigMissingCredentialFallbackEnabled)
 }
 
+func (p *Config) SelfServiceCodeMethodMaxSubmissions(ctx context.Context) int {
+	return p.GetProvider(ctx).IntF(ViperKeyCodeMaxSubmissions, 5)
+}
+
 func (p *Config) DatabaseCleanupSleepTables(ctx context.Context) time.Duration {
 	return p.GetProvider(ctx).Duration(ViperKeyDatabaseCleanupSleepTables)
 }
@@ -1478,7 +1684,92 @@ func (p *Config) PasswordPolicyConfig(ctx context.Context) *PasswordPolicy {
 		IgnoreNetworkErrors:              p.GetProvider(ctx).BoolF(ViperKeyIgnoreNetworkErrors, true),
 		MinPasswordLength:                uint(p.GetProvider(ctx).IntF(ViperKeyPasswordMinLength, 8)),
 		IdentifierSimilarityCheckEnabled: p.GetProvider(ctx).BoolF(ViperKeyPasswordIdentifierSimilarityCheckEnabled, true),
+		MinCharacterClasses:              uint(p.GetProvider(ctx).IntF(ViperKeyPasswordMinCharacterClasses, 0)),
+		DisallowedWords:                  p.GetProvider(ctx).Strings(ViperKeyPasswordDisallowedWords),
+	}
+}
+
+func (p *Config) PasswordLockoutConfig(ctx context.Context) *PasswordLockout {
+	return &PasswordLockout{
+		MaxAttempts:     p.GetProvider(ctx).IntF(ViperKeyPasswordMaxAttempts, 0),
+		LockoutDuration: p.GetProvider(ctx).DurationF(ViperKeyPasswordLockoutDuration, 15*time.Minute),
+		RevealLockout:   p.GetProvider(ctx).BoolF(ViperKeyPasswordRevealLockout, false),
+	}
+}
+
+func (p *Config) MaintenanceConfig(ctx context.Context) *Maintenance {
+	return &Maintenance{
+		Enabled:           p.GetProvider(ctx).BoolF(ViperKeyMaintenanceEnabled, false),
+		Message:           p.GetProvider(ctx).StringF(ViperKeyMaintenanceMessage, ""),
+		RetryAfterSeconds: p.GetProvider(ctx).IntF(ViperKeyMaintenanceRetryAfterSeconds, 0),
+		AllowWhoami:       p.GetProvider(ctx).BoolF(ViperKeyMaintenanceAllowWhoami, false),
+	}
+}
+
+// JsonnetTimeout returns how long a single Jsonnet evaluation may take. The
+// process isolated VM never runs longer than one second.
+func (p *Config) JsonnetTimeout(ctx context.Context) time.Duration {
+	return p.GetProvider(ctx).DurationF(ViperKeyJsonnetTimeout, time.Second)
+}
+
+// JsonnetMaxOutputSize returns the maximum size in bytes of the output of a
+// single Jsonnet evaluation.
+func (p *Config) JsonnetMaxOutputSize(ctx context.Context) int {
+	return p.GetProvider(ctx).IntF(ViperKeyJsonnetMaxOutputSize, 1024*1024)
+}
+
+// UIMessageOverrides returns the replacement texts for UI messages, keyed by
+// message ID.
+func (p *Config) UIMessageOverrides(ctx context.Context) map[text.ID]string {
+	raw := p.GetProvider(ctx).StringMap(ViperKeyUIMessageOverrides)
+	if len(raw) == 0 {
+		return nil
+	}
+
+	overrides := make(map[text.ID]string, len(raw))
+	for id, t := range raw {
+		n, err := strconv.Atoi(id)
+		if err != nil {
+			// The config schema only allows numeric message IDs.
+			continue
+		}
+		overrides[text.ID(n)] = t
+	}
+	return overrides
+}
+
+// ErrorsIncludeDebug reports whether error responses of the public API
+// include the `reason` and `debug` fields.
+func (p *Config) ErrorsIncludeDebug(ctx context.Context) bool {
+	return p.GetProvider(ctx).BoolF(ViperKeyErrorsIncludeDebug, true)
+}
+
+// ErrorsIDPrefix returns the prefix of error IDs in error responses.
+func (p *Config) ErrorsIDPrefix(ctx context.Context) string {
+	return p.GetProvider(ctx).StringF(ViperKeyErrorsIDPrefix, "")
+}
+
+// TenantMetaMaxSize is the maximum JSON encoded size of `x-tenant-meta`.
+const TenantMetaMaxSize = 4 * 1024
+
+// TenantMeta returns the vendor-specific metadata stored under `x-tenant-meta`.
+// Ory Kratos does not interpret these keys. An absent section yields an empty map.
+func (p *Config) TenantMeta(ctx context.Context) (map[string]any, error) {
+	meta := map[string]any{}
+	raw, err := json.Marshal(p.GetProvider(ctx).Get(ViperKeyTenantMeta))
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if len(raw) > TenantMetaMaxSize {
+		return nil, errors.Errorf("configuration key %s must not exceed %d bytes but is %d bytes", ViperKeyTenantMeta, TenantMetaMaxSize, len(raw))
+	}
+	if err := json.Unmarshal(raw, &meta); err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if meta == nil {
+		meta = map[string]any{}
 	}
+	return meta, nil
 }
 
 func (p *Config) WebAuthnForPasswordless(ctx context.Context) bool {
@@ -218,7 +218,7 @@ func TestViperProvider(t *testing.T) {
 				config  string
 				enabled bool
 			}{
-				{id: "password", enabled: true, config: `{"haveibeenpwned_host":"api.pwnedpasswords.com","haveibeenpwned_enabled":true,"ignore_network_errors":true,"max_breaches":0,"migrate_hook":{"config":{"emit_analytics_event":true,"method":"POST"},"enabled":false},"min_password_length":8,"identifier_similarity_check_enabled":true}`},
+				{id: "password", enabled: true, config: `{"haveibeenpwned_host":"api.pwnedpasswords.com","haveibeenpwned_enabled":true,"ignore_network_errors":true,"max_breaches":0,"migrate_hook":{"config":{"emit_analytics_event":true,"method":"POST"},"enabled":false},"min_password_length":8,"min_character_classes":0,"identifier_similarity_check_enabled":true,"max_attempts":0,"lockout_duration":"15m","reveal_lockout":false}`},
 				{id: "oidc", enabled: true, config: `{"providers":[{"client_id":"a","client_secret":"b","id":"github","provider":"github","mapper_url":"http://test.kratos.ory.sh/default-identity.schema.json"}]}`},
 				{id: "totp", enabled: true, config: `{"issuer":"issuer.ory.sh"}`},
 			} {
@@ -1338,3 +1338,97 @@ func TestCleanup(t *testing.T) {
 		assert.Equal(t, p.DatabaseCleanupBatchSize(ctx), 1)
 	})
 }
+
+func TestTenantMeta(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("case=absent section yields an empty map", func(t *testing.T) {
+		p := config.MustNew(t, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"))
+
+		meta, err := p.TenantMeta(ctx)
+		require.NoError(t, err)
+		assert.Empty(t, meta)
+	})
+
+	t.Run("case=arbitrary keys pass validation", func(t *testing.T) {
+		p := config.MustNew(t, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"),
+			configx.WithValue(config.ViperKeyTenantMeta, map[string]any{
+				"plan":    "enterprise",
+				"support": map[string]any{"tier": "gold"},
+			}))
+
+		meta, err := p.TenantMeta(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, "enterprise", meta["plan"])
+		assert.Equal(t, map[string]any{"tier": "gold"}, meta["support"])
+	})
+
+	t.Run("case=oversized section is rejected", func(t *testing.T) {
+		p := config.MustNew(t, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"),
+			configx.WithValue(config.ViperKeyTenantMeta, map[string]any{
+				"notes": strings.Repeat("a", config.TenantMetaMaxSize),
+			}))
+
+		_, err := p.TenantMeta(ctx)
+		require.ErrorContains(t, err, "must not exceed")
+	})
+}
+
+func TestCourierAllowedCategories(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("case=all categories are allowed by default", func(t *testing.T) {
+		p := config.MustNew(t, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"))
+		assert.Empty(t, p.CourierAllowedCategories(ctx))
+	})
+
+	t.Run("case=security must be allowed", func(t *testing.T) {
+		p := config.MustNew(t, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"),
+			configx.WithValue(config.ViperKeyCourierAllowedCategories, []string{"security"}))
+		assert.Equal(t, []string{"security"}, p.CourierAllowedCategories(ctx))
+
+		_, err := config.New(ctx, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"),
+			configx.WithValue(config.ViperKeyCourierAllowedCategories, []string{"transactional"}))
+		assert.Error(t, err)
+	})
+}
+
+func TestPublicClientIP(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("case=parses trusted networks", func(t *testing.T) {
+		p := config.MustNew(t, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.WithConfigFiles("stub/.kratos.yaml"),
+			configx.WithValue(config.ViperKeyPublicClientIPHeader, "X-Forwarded-For"),
+			configx.WithValue(config.ViperKeyPublicClientIPTrustedCIDRs, []string{"10.0.0.0/8", "fd00::/8"}))
+
+		c := p.PublicClientIP(ctx)
+		require.Len(t, c.TrustedCIDRs, 2)
+		assert.Equal(t, "10.0.0.0/8", c.TrustedCIDRs[0].String())
+		assert.Equal(t, "fd00::/8", c.TrustedCIDRs[1].String())
+		assert.Same(t, c, p.PublicClientIP(ctx), "the settings are cached while the config is unchanged")
+
+		p.MustSet(ctx, config.ViperKeyPublicClientIPTrustedCIDRs, []string{"192.168.0.0/16"})
+		c = p.PublicClientIP(ctx)
+		require.Len(t, c.TrustedCIDRs, 1)
+		assert.Equal(t, "192.168.0.0/16", c.TrustedCIDRs[0].String())
+	})
+
+	t.Run("case=rejects invalid networks", func(t *testing.T) {
+		for _, cidr := range []string{"10.0.0.0", "10.0.0.256/8", "10.0.0.0/33", "fd00::/129", "not-a-network"} {
+			_, err := config.New(ctx, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+				configx.WithConfigFiles("stub/.kratos.yaml"),
+				configx.WithValue(config.ViperKeyPublicClientIPTrustedCIDRs, []string{cidr}))
+			assert.Error(t, err, cidr)
+		}
+	})
+}
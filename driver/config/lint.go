@@ -0,0 +1,110 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+const LintSeverityWarning = "warning"
+
+type (
+	// LintFinding describes a configuration which is valid according to the
+	// schema but likely wrong in operation.
+	LintFinding struct {
+		// ID identifies the rule which produced the finding.
+		ID string `json:"id"`
+
+		// Severity is always "warning". Findings never block loading the config.
+		Severity string `json:"severity"`
+
+		// Message explains the problem and how to fix it.
+		Message string `json:"message"`
+
+		// Pointer is the JSON pointer of the offending configuration key.
+		Pointer string `json:"pointer"`
+	}
+
+	lintRule func(ctx context.Context, p *Config) []LintFinding
+)
+
+var lintRules = []lintRule{
+	lintOIDCWithoutProviders,
+	lintSessionLifespanBelowPrivilegedMaxAge,
+	lintInsecureUIURLs,
+}
+
+// Lint runs all lint rules against the configuration of the context.
+func (p *Config) Lint(ctx context.Context) []LintFinding {
+	var findings []LintFinding
+	for _, rule := range lintRules {
+		findings = append(findings, rule(ctx, p)...)
+	}
+	return findings
+}
+
+func keyToPointer(key string) string {
+	return "/" + strings.ReplaceAll(key, ".", "/")
+}
+
+func lintOIDCWithoutProviders(ctx context.Context, p *Config) []LintFinding {
+	key := ViperKeySelfServiceStrategyConfig + ".oidc"
+	providers, _ := p.GetProvider(ctx).Get(key + ".config.providers").([]any)
+	if !p.GetProvider(ctx).Bool(key+".enabled") || len(providers) > 0 {
+		return nil
+	}
+
+	return []LintFinding{{
+		ID:       "oidc-without-providers",
+		Severity: LintSeverityWarning,
+		Message:  "The oidc method is enabled but no providers are configured. Add a provider or disable the method.",
+		Pointer:  keyToPointer(key + ".config.providers"),
+	}}
+}
+
+func lintSessionLifespanBelowPrivilegedMaxAge(ctx context.Context, p *Config) []LintFinding {
+	lifespan, maxAge := p.SessionLifespan(ctx), p.SelfServiceFlowSettingsPrivilegedSessionMaxAge(ctx)
+	if lifespan >= maxAge {
+		return nil
+	}
+
+	return []LintFinding{{
+		ID:       "session-lifespan-below-privileged-max-age",
+		Severity: LintSeverityWarning,
+		Message:  fmt.Sprintf("The session lifespan (%s) is shorter than the privileged session max age (%s), so sessions are always privileged.", lifespan, maxAge),
+		Pointer:  keyToPointer(ViperKeySessionLifespan),
+	}}
+}
+
+func lintInsecureUIURLs(ctx context.Context, p *Config) []LintFinding {
+	if p.IsInsecureDevMode(ctx) {
+		return nil
+	}
+
+	var findings []LintFinding
+	for _, key := range []string{
+		ViperKeySelfServiceErrorUI,
+		ViperKeySelfServiceLoginUI,
+		ViperKeySelfServiceRegistrationUI,
+		ViperKeySelfServiceSettingsURL,
+		ViperKeySelfServiceRecoveryUI,
+		ViperKeySelfServiceVerificationUI,
+	} {
+		u, err := url.Parse(p.GetProvider(ctx).String(key))
+		if err != nil || u.Scheme != "http" {
+			continue
+		}
+
+		findings = append(findings, LintFinding{
+			ID:       "insecure-ui-url",
+			Severity: LintSeverityWarning,
+			Message:  fmt.Sprintf("The UI URL %s uses plain HTTP outside of dev mode. Use HTTPS to protect cookies and tokens.", u.Redacted()),
+			Pointer:  keyToPointer(key),
+		})
+	}
+	return findings
+}
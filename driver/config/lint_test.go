@@ -0,0 +1,86 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package config_test
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/x/configx"
+	"github.com/ory/x/contextx"
+	"github.com/ory/x/logrusx"
+)
+
+func TestLint(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	lint := func(t *testing.T, values map[string]any) []config.LintFinding {
+		conf, err := config.New(ctx, logrusx.New("", ""), os.Stderr, &contextx.Default{},
+			configx.SkipValidation(),
+			configx.WithValues(values))
+		require.NoError(t, err)
+		return conf.Lint(ctx)
+	}
+
+	findingIDs := func(findings []config.LintFinding) (ids []string) {
+		for _, f := range findings {
+			assert.Equal(t, config.LintSeverityWarning, f.Severity)
+			ids = append(ids, f.ID)
+		}
+		return ids
+	}
+
+	t.Run("case=no findings for a sane configuration", func(t *testing.T) {
+		assert.Empty(t, lint(t, map[string]any{
+			config.ViperKeySelfServiceLoginUI: "https://example.com/login",
+		}))
+	})
+
+	t.Run("rule=oidc-without-providers", func(t *testing.T) {
+		findings := lint(t, map[string]any{
+			config.ViperKeySelfServiceStrategyConfig + ".oidc.enabled": true,
+		})
+		assert.Equal(t, []string{"oidc-without-providers"}, findingIDs(findings))
+		assert.Equal(t, "/selfservice/methods/oidc/config/providers", findings[0].Pointer)
+
+		assert.Empty(t, lint(t, map[string]any{
+			config.ViperKeySelfServiceStrategyConfig + ".oidc.enabled":          true,
+			config.ViperKeySelfServiceStrategyConfig + ".oidc.config.providers": []any{map[string]any{"id": "github"}},
+		}))
+	})
+
+	t.Run("rule=session-lifespan-below-privileged-max-age", func(t *testing.T) {
+		findings := lint(t, map[string]any{
+			config.ViperKeySessionLifespan:                                  "10m",
+			config.ViperKeySelfServiceSettingsPrivilegedAuthenticationAfter: "1h",
+		})
+		assert.Equal(t, []string{"session-lifespan-below-privileged-max-age"}, findingIDs(findings))
+		assert.Equal(t, "/session/lifespan", findings[0].Pointer)
+
+		assert.Empty(t, lint(t, map[string]any{
+			config.ViperKeySessionLifespan:                                  "24h",
+			config.ViperKeySelfServiceSettingsPrivilegedAuthenticationAfter: "1h",
+		}))
+	})
+
+	t.Run("rule=insecure-ui-url", func(t *testing.T) {
+		findings := lint(t, map[string]any{
+			config.ViperKeySelfServiceLoginUI:     "http://example.com/login",
+			config.ViperKeySelfServiceSettingsURL: "https://example.com/settings",
+		})
+		assert.Equal(t, []string{"insecure-ui-url"}, findingIDs(findings))
+		assert.Equal(t, "/selfservice/flows/login/ui_url", findings[0].Pointer)
+
+		assert.Empty(t, lint(t, map[string]any{
+			config.ViperKeySelfServiceLoginUI: "http://example.com/login",
+			"dev":                             true,
+		}), "plain HTTP is fine in dev mode")
+	})
+}
@@ -45,6 +45,7 @@ import (
 	"github.com/ory/kratos/selfservice/errorx"
 	password2 "github.com/ory/kratos/selfservice/strategy/password"
 	"github.com/ory/kratos/session"
+	"github.com/ory/kratos/usage"
 )
 
 type Registry interface {
@@ -100,14 +101,20 @@ type Registry interface {
 	identity.PrivilegedPoolProvider
 	identity.ManagementProvider
 	identity.ActiveCredentialsCounterStrategyProvider
+	identity.WebHookDeliveryPersistenceProvider
+	identity.WebHookDispatcherProvider
 
 	courier.HandlerProvider
 	courier.PersistenceProvider
 
+	usage.HandlerProvider
+	usage.PersistenceProvider
+
 	schema.HandlerProvider
 	schema.IdentitySchemaProvider
 
 	password2.ValidationProvider
+	password2.PolicyHandlerProvider
 
 	session.HandlerProvider
 	session.ManagementProvider
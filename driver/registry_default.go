@@ -52,6 +52,7 @@ import (
 	"github.com/ory/kratos/selfservice/strategy/totp"
 	"github.com/ory/kratos/selfservice/strategy/webauthn"
 	"github.com/ory/kratos/session"
+	"github.com/ory/kratos/usage"
 	"github.com/ory/kratos/x"
 	"github.com/ory/nosurf"
 	"github.com/ory/x/contextx"
@@ -98,9 +99,11 @@ type RegistryDefault struct {
 	identityHandler        *identity.Handler
 	identityValidator      *identity.Validator
 	identityManager        *identity.Manager
+	identityWebHooks       *identity.WebHookDispatcher
 	identitySchemaProvider schema.IdentitySchemaProvider
 
 	courierHandler *courier.Handler
+	usageHandler   *usage.Handler
 
 	continuityManager continuity.Manager
 
@@ -112,6 +115,7 @@ type RegistryDefault struct {
 
 	passwordHasher    hash.Hasher
 	passwordValidator password.Validator
+	passwordPolicy    *password.PolicyHandler
 
 	crypter cipher.Cipher
 
@@ -161,13 +165,6 @@ type RegistryDefault struct {
 	jwkFetcher        *jwksx.FetcherNext
 }
 
-func (m *RegistryDefault) JsonnetVM(ctx context.Context) (jsonnetsecure.VM, error) {
-	if m.jsonnetVMProvider == nil {
-		m.jsonnetVMProvider = &jsonnetsecure.DefaultProvider{Subcommand: "jsonnet", Pool: m.jsonnetPool}
-	}
-	return m.jsonnetVMProvider.JsonnetVM(ctx)
-}
-
 func (m *RegistryDefault) Audit() *logrusx.Logger {
 	return m.Logger().WithField("audience", "audit")
 }
@@ -179,6 +176,8 @@ func (m *RegistryDefault) RegisterPublicRoutes(ctx context.Context, router *x.Ro
 	m.SettingsHandler().RegisterPublicRoutes(router)
 	m.IdentityHandler().RegisterPublicRoutes(router)
 	m.CourierHandler().RegisterPublicRoutes(router)
+	m.UsageHandler().RegisterPublicRoutes(router)
+	m.PasswordPolicyHandler().RegisterPublicRoutes(router)
 	m.AllLoginStrategies().RegisterPublicRoutes(router)
 	m.AllSettingsStrategies().RegisterPublicRoutes(router)
 	m.AllRegistrationStrategies().RegisterPublicRoutes(router)
@@ -203,6 +202,8 @@ func (m *RegistryDefault) RegisterAdminRoutes(ctx context.Context, router *x.Rou
 	m.SettingsHandler().RegisterAdminRoutes(router)
 	m.IdentityHandler().RegisterAdminRoutes(router)
 	m.CourierHandler().RegisterAdminRoutes(router)
+	m.UsageHandler().RegisterAdminRoutes(router)
+	m.PasswordPolicyHandler().RegisterAdminRoutes(router)
 	m.SelfServiceErrorHandler().RegisterAdminRoutes(router)
 
 	m.RecoveryHandler().RegisterAdminRoutes(router)
@@ -434,8 +435,9 @@ func (m *RegistryDefault) WithSelfserviceStrategies(t testing.TB, strategies []a
 
 func (m *RegistryDefault) Writer() herodot.Writer {
 	if m.writer == nil {
-		h := herodot.NewJSONWriter(m.Logger())
-		m.writer = h
+		w := herodot.NewJSONWriter(m.Logger())
+		w.ErrorEnhancer = errorOptionsEnhancer(m.Config())
+		m.writer = &messageOverridesWriter{Writer: w, c: m.Config()}
 	}
 	return m.writer
 }
@@ -461,6 +463,13 @@ func (m *RegistryDefault) CourierHandler() *courier.Handler {
 	return m.courierHandler
 }
 
+func (m *RegistryDefault) UsageHandler() *usage.Handler {
+	if m.usageHandler == nil {
+		m.usageHandler = usage.NewHandler(m)
+	}
+	return m.usageHandler
+}
+
 func (m *RegistryDefault) SchemaHandler() *schema.Handler {
 	if m.schemaHandler == nil {
 		m.schemaHandler = schema.NewHandler(m)
@@ -512,6 +521,13 @@ func (m *RegistryDefault) PasswordValidator() password.Validator {
 	return m.passwordValidator
 }
 
+func (m *RegistryDefault) PasswordPolicyHandler() *password.PolicyHandler {
+	if m.passwordPolicy == nil {
+		m.passwordPolicy = password.NewPolicyHandler(m)
+	}
+	return m.passwordPolicy
+}
+
 func (m *RegistryDefault) SelfServiceErrorHandler() *errorx.Handler {
 	if m.errorHandler == nil {
 		m.errorHandler = errorx.NewHandler(m)
@@ -773,6 +789,14 @@ func (m *RegistryDefault) CourierPersister() courier.Persister {
 	return m.persister
 }
 
+func (m *RegistryDefault) UsagePersister() usage.Persister {
+	return m.persister
+}
+
+func (m *RegistryDefault) IdentityWebHookDeliveryPersister() identity.WebHookDeliveryPersister {
+	return m.persister
+}
+
 func (m *RegistryDefault) RecoveryTokenPersister() link.RecoveryTokenPersister {
 	return m.Persister()
 }
@@ -801,6 +825,10 @@ func (m *RegistryDefault) TransactionalPersisterProvider() x.TransactionalPersis
 	return m.Persister()
 }
 
+func (m *RegistryDefault) LoginAttemptsPersister() password.LoginAttemptsPersister {
+	return m.Persister()
+}
+
 func (m *RegistryDefault) Persister() persistence.Persister {
 	return m.persister
 }
@@ -827,6 +855,13 @@ func (m *RegistryDefault) IdentityManager() *identity.Manager {
 	return m.identityManager
 }
 
+func (m *RegistryDefault) IdentityWebHookDispatcher() *identity.WebHookDispatcher {
+	if m.identityWebHooks == nil {
+		m.identityWebHooks = identity.NewWebHookDispatcher(m)
+	}
+	return m.identityWebHooks
+}
+
 func (m *RegistryDefault) PrometheusManager() *prometheus.MetricsManager {
 	m.rwl.Lock()
 	defer m.rwl.Unlock()
@@ -0,0 +1,78 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/ory/x/jsonnetsecure"
+
+	pkgerrors "github.com/pkg/errors"
+)
+
+func (m *RegistryDefault) JsonnetVM(ctx context.Context) (jsonnetsecure.VM, error) {
+	if m.jsonnetVMProvider == nil {
+		m.jsonnetVMProvider = &jsonnetsecure.DefaultProvider{Subcommand: "jsonnet", Pool: m.jsonnetPool}
+	}
+	return &limitedJsonnetVM{
+		ctx:           ctx,
+		provider:      m.jsonnetVMProvider,
+		timeout:       m.Config().JsonnetTimeout(ctx),
+		maxOutputSize: m.Config().JsonnetMaxOutputSize(ctx),
+	}, nil
+}
+
+// limitedJsonnetVM enforces `jsonnet.timeout` and `jsonnet.max_output_size`.
+// It creates the underlying VM for each evaluation so that the evaluation runs
+// under its own deadline.
+type limitedJsonnetVM struct {
+	ctx           context.Context
+	provider      jsonnetsecure.VMProvider
+	timeout       time.Duration
+	maxOutputSize int
+	params        []func(jsonnetsecure.VM)
+}
+
+func (vm *limitedJsonnetVM) EvaluateAnonymousSnippet(filename string, snippet string) (string, error) {
+	ctx, cancel := context.WithTimeout(vm.ctx, vm.timeout)
+	defer cancel()
+
+	inner, err := vm.provider.JsonnetVM(ctx)
+	if err != nil {
+		return "", err
+	}
+	for _, set := range vm.params {
+		set(inner)
+	}
+
+	out, err := inner.EvaluateAnonymousSnippet(filename, snippet)
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return "", pkgerrors.Errorf("jsonnet: evaluation of %s did not finish within %s", filename, vm.timeout)
+	} else if err != nil {
+		return "", err
+	}
+
+	if len(out) > vm.maxOutputSize {
+		return "", pkgerrors.Errorf("jsonnet: evaluation of %s produced %d bytes which exceeds the maximum of %d bytes", filename, len(out), vm.maxOutputSize)
+	}
+	return out, nil
+}
+
+func (vm *limitedJsonnetVM) ExtCode(key string, val string) {
+	vm.params = append(vm.params, func(inner jsonnetsecure.VM) { inner.ExtCode(key, val) })
+}
+
+func (vm *limitedJsonnetVM) ExtVar(key string, val string) {
+	vm.params = append(vm.params, func(inner jsonnetsecure.VM) { inner.ExtVar(key, val) })
+}
+
+func (vm *limitedJsonnetVM) TLACode(key string, val string) {
+	vm.params = append(vm.params, func(inner jsonnetsecure.VM) { inner.TLACode(key, val) })
+}
+
+func (vm *limitedJsonnetVM) TLAVar(key string, val string) {
+	vm.params = append(vm.params, func(inner jsonnetsecure.VM) { inner.TLAVar(key, val) })
+}
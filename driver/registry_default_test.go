@@ -7,8 +7,10 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/http/httptest"
 	"os"
 	"testing"
+	"time"
 
 	confighelpers "github.com/ory/kratos/driver/config/testhelpers"
 
@@ -20,10 +22,12 @@ import (
 
 	"github.com/ory/kratos/driver"
 	"github.com/ory/x/configx"
+	"github.com/ory/x/jsonnetsecure"
 	"github.com/ory/x/logrusx"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
 
 	"github.com/ory/kratos/driver/config"
 	"github.com/ory/kratos/identity"
@@ -32,6 +36,8 @@ import (
 	"github.com/ory/kratos/selfservice/flow/registration"
 	"github.com/ory/kratos/selfservice/flow/settings"
 	"github.com/ory/kratos/selfservice/hook"
+	"github.com/ory/kratos/text"
+	"github.com/ory/kratos/ui/container"
 )
 
 func TestDriverDefault_Hooks(t *testing.T) {
@@ -971,3 +977,56 @@ func TestGetActiveVerificationStrategy(t *testing.T) {
 		}
 	})
 }
+
+func TestDefaultRegistry_JsonnetVM(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, reg := internal.NewVeryFastRegistryWithoutDB(t)
+	reg.WithJsonnetVMProvider(jsonnetsecure.NewTestProvider(t))
+
+	evaluate := func(ctx context.Context, snippet string) (string, error) {
+		vm, err := reg.JsonnetVM(ctx)
+		require.NoError(t, err)
+		vm.ExtCode("value", `"bar"`)
+		return vm.EvaluateAnonymousSnippet("test.jsonnet", snippet)
+	}
+
+	t.Run("case=evaluates within the limits", func(t *testing.T) {
+		out, err := evaluate(ctx, `{foo: std.extVar("value")}`)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"foo":"bar"}`, out)
+	})
+
+	t.Run("case=fails if the evaluation exceeds the timeout", func(t *testing.T) {
+		ctx := confighelpers.WithConfigValue(ctx, config.ViperKeyJsonnetTimeout, "10ms")
+		_, err := evaluate(ctx, `std.foldl(function(a, b) a + b, std.range(0, 100000000), 0)`)
+		require.ErrorContains(t, err, "did not finish within 10ms")
+	})
+
+	t.Run("case=fails if the output exceeds the maximum size", func(t *testing.T) {
+		ctx := confighelpers.WithConfigValue(ctx, config.ViperKeyJsonnetMaxOutputSize, 64)
+		_, err := evaluate(ctx, `{foo: std.extVar("value")}`)
+		require.NoError(t, err)
+		_, err = evaluate(ctx, `{foo: std.repeat(std.extVar("value"), 30)}`)
+		require.ErrorContains(t, err, "exceeds the maximum of 64 bytes")
+	})
+}
+
+func TestDefaultRegistry_MessageOverrides(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	_, reg := internal.NewVeryFastRegistryWithoutDB(t)
+	ctx = confighelpers.WithConfigValue(ctx, config.ViperKeyUIMessageOverrides, map[string]any{
+		fmt.Sprintf("%d", text.ErrorValidationLoginFlowExpired): "Please sign in again.",
+	})
+
+	f := &login.Flow{UI: container.New("https://www.ory.sh/")}
+	f.UI.Messages.Add(text.NewErrorValidationLoginFlowExpired(time.Now()))
+	original := f.UI.Messages[0].Text
+
+	rec := httptest.NewRecorder()
+	reg.Writer().Write(rec, httptest.NewRequest("GET", "/", nil).WithContext(ctx), f)
+
+	assert.Equal(t, "Please sign in again.", gjson.GetBytes(rec.Body.Bytes(), "ui.messages.0.text").String(), "%s", rec.Body.Bytes())
+	assert.Equal(t, original, f.UI.Messages[0].Text, "the flow itself must keep the original message")
+}
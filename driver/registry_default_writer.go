@@ -0,0 +1,104 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package driver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/ory/herodot"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/ui/container"
+	"github.com/ory/kratos/x"
+)
+
+// messageOverridesWriter applies `ui.message_overrides` to the UI of every
+// flow written to a response.
+type messageOverridesWriter struct {
+	herodot.Writer
+	c *config.Config
+}
+
+func (w *messageOverridesWriter) Write(rw http.ResponseWriter, r *http.Request, e interface{}, opts ...herodot.EncoderOptions) {
+	w.Writer.Write(rw, r, w.overrideMessages(r, e), opts...)
+}
+
+func (w *messageOverridesWriter) WriteCode(rw http.ResponseWriter, r *http.Request, code int, e interface{}, opts ...herodot.EncoderOptions) {
+	w.Writer.WriteCode(rw, r, code, w.overrideMessages(r, e), opts...)
+}
+
+func (w *messageOverridesWriter) WriteCreated(rw http.ResponseWriter, r *http.Request, location string, e interface{}) {
+	w.Writer.WriteCreated(rw, r, location, w.overrideMessages(r, e))
+}
+
+// overrideMessages returns the payload to encode. The overrides are applied to
+// a copy of the UI so that the flow itself, which may still be persisted or
+// reused by the caller, keeps the original messages.
+func (w *messageOverridesWriter) overrideMessages(r *http.Request, e interface{}) interface{} {
+	f, ok := e.(interface{ GetUI() *container.Container })
+	if !ok {
+		return e
+	}
+
+	ui := f.GetUI()
+	if ui == nil {
+		return e
+	}
+
+	overrides := w.c.UIMessageOverrides(r.Context())
+	if len(overrides) == 0 {
+		return e
+	}
+
+	raw, err := json.Marshal(e)
+	if err != nil {
+		return e
+	}
+
+	var overridden container.Container
+	if err := json.Unmarshal([]byte(gjson.GetBytes(raw, "ui").Raw), &overridden); err != nil {
+		return e
+	}
+	overridden.OverrideMessages(overrides)
+
+	if raw, err = sjson.SetBytes(raw, "ui", &overridden); err != nil {
+		return e
+	}
+	return json.RawMessage(raw)
+}
+
+// errorOptionsEnhancer encodes errors like herodot's default enhancer does and
+// applies `errors.id_prefix` and `errors.include_debug` to the result.
+func errorOptionsEnhancer(c *config.Config) func(r *http.Request, err error) interface{} {
+	return func(r *http.Request, err error) interface{} {
+		var payload interface{}
+		if e, ok := err.(herodot.ErrorEnhancer); ok {
+			payload = e.EnhanceJSONError()
+		} else {
+			payload = &herodot.ErrorContainer{Error: herodot.ToDefaultError(err, r.Header.Get("X-Request-ID"))}
+		}
+
+		idPrefix, strip := x.ErrorOptions(c, r)
+		if idPrefix == "" && !strip {
+			return payload
+		}
+
+		raw, encErr := json.Marshal(payload)
+		if encErr != nil {
+			return payload
+		}
+		// herodot only removes the debug field from its own error types.
+		if raw, encErr = sjson.DeleteBytes(raw, "error.debug"); encErr != nil {
+			return payload
+		}
+		if raw, encErr = x.ApplyErrorOptions(raw, "error", idPrefix, strip); encErr != nil {
+			return payload
+		}
+		return json.RawMessage(raw)
+	}
+}
@@ -131,6 +131,10 @@ const (
 	// It is not used within the credentials object itself.
 	CredentialsTypeRecoveryLink CredentialsType = "link_recovery"
 	CredentialsTypeRecoveryCode CredentialsType = "code_recovery"
+
+	// CredentialsTypeImpersonation is the authentication method of sessions issued by the admin impersonation API.
+	// It is not used within the credentials object itself.
+	CredentialsTypeImpersonation CredentialsType = "impersonation"
 )
 
 // ParseCredentialsType parses a string into a CredentialsType or returns false as the second argument.
@@ -43,8 +43,14 @@ const (
 	RouteCollection     = "/identities"
 	RouteItem           = RouteCollection + "/:id"
 	RouteCredentialItem = RouteItem + "/credentials/:type"
+	RouteImport         = RouteCollection + "/import"
+
+	RouteWebHookDeliveries = "/identity-webhook-deliveries"
 
 	BatchPatchIdentitiesLimit = 2000
+
+	ImportIdentitiesDefaultBatchSize = 100
+	ImportIdentitiesMaxLineSize      = 1024 * 1024
 )
 
 type (
@@ -57,6 +63,7 @@ type (
 		x.CSRFProvider
 		cipher.Provider
 		hash.HashProvider
+		WebHookDeliveryPersistenceProvider
 	}
 	HandlerProvider interface {
 		IdentityHandler() *Handler
@@ -93,6 +100,7 @@ func (h *Handler) RegisterPublicRoutes(public *x.RouterPublic) {
 	public.PUT(RouteItem, x.RedirectToAdminRoute(h.r))
 	public.PATCH(RouteItem, x.RedirectToAdminRoute(h.r))
 	public.DELETE(RouteCredentialItem, x.RedirectToAdminRoute(h.r))
+	public.POST(RouteImport, x.RedirectToAdminRoute(h.r))
 
 	public.GET(x.AdminPrefix+RouteCollection, x.RedirectToAdminRoute(h.r))
 	public.GET(x.AdminPrefix+RouteItem, x.RedirectToAdminRoute(h.r))
@@ -101,6 +109,8 @@ func (h *Handler) RegisterPublicRoutes(public *x.RouterPublic) {
 	public.PUT(x.AdminPrefix+RouteItem, x.RedirectToAdminRoute(h.r))
 	public.PATCH(x.AdminPrefix+RouteItem, x.RedirectToAdminRoute(h.r))
 	public.DELETE(x.AdminPrefix+RouteCredentialItem, x.RedirectToAdminRoute(h.r))
+	public.POST(x.AdminPrefix+RouteImport, x.RedirectToAdminRoute(h.r))
+	public.GET(x.AdminPrefix+RouteWebHookDeliveries, x.RedirectToAdminRoute(h.r))
 }
 
 func (h *Handler) RegisterAdminRoutes(admin *x.RouterAdmin) {
@@ -111,9 +121,12 @@ func (h *Handler) RegisterAdminRoutes(admin *x.RouterAdmin) {
 
 	admin.POST(RouteCollection, h.create)
 	admin.PATCH(RouteCollection, h.batchPatchIdentities)
+	admin.POST(RouteImport, h.importIdentities)
 	admin.PUT(RouteItem, h.update)
 
 	admin.DELETE(RouteCredentialItem, h.deleteIdentityCredentials)
+
+	admin.GET(RouteWebHookDeliveries, h.listWebHookDeliveries)
 }
 
 // Paginated Identity List Response
@@ -817,7 +830,7 @@ type deleteIdentity struct {
 //	  404: errorGeneric
 //	  default: errorGeneric
 func (h *Handler) delete(w http.ResponseWriter, r *http.Request, ps httprouter.Params) {
-	if err := h.r.PrivilegedIdentityPool().DeleteIdentity(r.Context(), x.ParseUUID(ps.ByName("id"))); err != nil {
+	if err := h.r.IdentityManager().Delete(r.Context(), x.ParseUUID(ps.ByName("id"))); err != nil {
 		h.r.Writer().WriteError(w, r, err)
 		return
 	}
@@ -4,14 +4,20 @@
 package identity
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
+	"net/http"
+	"strconv"
 
+	"github.com/julienschmidt/httprouter"
 	"github.com/pkg/errors"
 
 	"github.com/ory/herodot"
 	"github.com/ory/kratos/hash"
 	"github.com/ory/kratos/x"
+	"github.com/ory/x/jsonx"
 )
 
 func (h *Handler) importCredentials(ctx context.Context, i *Identity, creds *IdentityWithCredentials) error {
@@ -101,3 +107,177 @@ func (h *Handler) importOIDCCredentials(_ context.Context, i *Identity, creds *A
 	}
 	return i.SetCredentialsWithConfig(CredentialsTypeOIDC, *c, &target)
 }
+
+// Import Identities Parameters
+//
+// swagger:parameters importIdentities
+//
+//nolint:deadcode,unused
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type importIdentities struct {
+	// The number of identities created per transaction. Defaults to 100 and
+	// must not exceed 2000.
+	//
+	// in: query
+	BatchSize int `json:"batch_size"`
+
+	// The number of lines to skip. Use it to resume an interrupted import
+	// from the first line that was not imported.
+	//
+	// in: query
+	Offset int `json:"offset"`
+
+	// One createIdentityBody per line (newline-delimited JSON).
+	//
+	// in: body
+	Body string
+}
+
+// Import identities response
+//
+// swagger:model importIdentitiesResponse
+type ImportIdentitiesResponse struct {
+	// The number of identities which were created.
+	//
+	// required: true
+	Imported int `json:"imported"`
+
+	// The number of lines which were skipped because of the offset.
+	//
+	// required: true
+	Skipped int `json:"skipped"`
+
+	// The lines which could not be imported.
+	//
+	// required: true
+	Failed []ImportIdentityFailure `json:"failed"`
+}
+
+// A line which could not be imported
+//
+// swagger:model importIdentityFailure
+type ImportIdentityFailure struct {
+	// The line number, starting at 1.
+	//
+	// required: true
+	Line int `json:"line"`
+
+	// Why the line could not be imported.
+	//
+	// required: true
+	Error *herodot.DefaultError `json:"error"`
+}
+
+// swagger:route POST /admin/identities/import identity importIdentities
+//
+// # Import identities from newline-delimited JSON
+//
+// Streams identities from the request body, one createIdentityBody per
+// line, and creates them in batches. Credentials can be imported the same
+// way as with the create identity endpoint, including hashed passwords.
+//
+// Lines which fail to parse, validate, or insert are reported with their
+// line number and do not abort the import.
+//
+//	Consumes:
+//	- application/x-ndjson
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Security:
+//	  oryAccessToken:
+//
+//	Responses:
+//	  200: importIdentitiesResponse
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *Handler) importIdentities(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	ctx := r.Context()
+
+	batchSize, offset := ImportIdentitiesDefaultBatchSize, 0
+	for key, target := range map[string]*int{"batch_size": &batchSize, "offset": &offset} {
+		if raw := r.URL.Query().Get(key); raw != "" {
+			v, err := strconv.Atoi(raw)
+			if err != nil || v < 0 {
+				h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Query parameter %s must be a non-negative integer.", key)))
+				return
+			}
+			*target = v
+		}
+	}
+	if batchSize < 1 || batchSize > BatchPatchIdentitiesLimit {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf(
+			"Query parameter batch_size must be between 1 and %d.", BatchPatchIdentitiesLimit)))
+		return
+	}
+
+	res := ImportIdentitiesResponse{Failed: []ImportIdentityFailure{}}
+	batch := make([]*Identity, 0, batchSize)
+	lines := make([]int, 0, batchSize)
+	flush := func() error {
+		if len(batch) == 0 {
+			return nil
+		}
+
+		err := h.r.IdentityManager().CreateIdentities(ctx, batch)
+		partialErr := new(CreateIdentitiesError)
+		if err != nil && !errors.As(err, &partialErr) {
+			return err
+		}
+		for k, ident := range batch {
+			if failed := partialErr.Find(ident); failed != nil {
+				res.Failed = append(res.Failed, ImportIdentityFailure{Line: lines[k], Error: failed.Error})
+			} else {
+				res.Imported++
+			}
+		}
+
+		batch, lines = batch[:0], lines[:0]
+		return nil
+	}
+
+	scanner := bufio.NewScanner(r.Body)
+	scanner.Buffer(make([]byte, 0, 64*1024), ImportIdentitiesMaxLineSize)
+	for line := 1; scanner.Scan(); line++ {
+		if line <= offset {
+			res.Skipped++
+			continue
+		}
+		if len(bytes.TrimSpace(scanner.Bytes())) == 0 {
+			continue
+		}
+
+		var body CreateIdentityBody
+		if err := jsonx.NewStrictDecoder(bytes.NewReader(scanner.Bytes())).Decode(&body); err != nil {
+			res.Failed = append(res.Failed, ImportIdentityFailure{Line: line, Error: herodot.ErrBadRequest.WithReasonf("Unable to decode the identity: %s", err)})
+			continue
+		}
+
+		ident, err := h.identityFromCreateIdentityBody(ctx, &body)
+		if err != nil {
+			res.Failed = append(res.Failed, ImportIdentityFailure{Line: line, Error: herodot.ToDefaultError(err, "")})
+			continue
+		}
+
+		batch, lines = append(batch, ident), append(lines, line)
+		if len(batch) == batchSize {
+			if err := flush(); err != nil {
+				h.r.Writer().WriteError(w, r, err)
+				return
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Unable to read the request body: %s", err)))
+		return
+	}
+	if err := flush(); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Writer().Write(w, r, &res)
+}
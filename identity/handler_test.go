@@ -890,6 +890,80 @@ func TestHandler(t *testing.T) {
 		})
 	})
 
+	t.Run("suite=import identities", func(t *testing.T) {
+		importNDJSON := func(t *testing.T, query string, lines []string, expectCode int) gjson.Result {
+			t.Helper()
+			res, err := adminTS.Client().Post(adminTS.URL+identity.RouteImport+query, "application/x-ndjson", strings.NewReader(strings.Join(lines, "\n")))
+			require.NoError(t, err)
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			require.NoError(t, res.Body.Close())
+			require.EqualValues(t, expectCode, res.StatusCode, "%s", body)
+			return gjson.ParseBytes(body)
+		}
+
+		encode := func(t *testing.T, body *identity.CreateIdentityBody) string {
+			raw, err := json.Marshal(body)
+			require.NoError(t, err)
+			return string(raw)
+		}
+
+		hashedPassword := "$2a$08$.cOYmAd.vCpDOoiVJrO5B.hjTLKQQ6cAK40u8uB.FnZDyPvVvQ9Q."
+		var lines []string
+		var failedLines []int64
+		for i := 0; i < 60; i++ {
+			switch {
+			case i == 30:
+				lines = append(lines, "{not json")
+			case i%10 == 9:
+				lines = append(lines, encode(t, &identity.CreateIdentityBody{SchemaID: "multiple_emails", Traits: json.RawMessage(`"invalid traits"`)}))
+			case i == 45:
+				lines = append(lines, lines[0]) // duplicate
+			default:
+				body := validCreateIdentityBody("ndjson-import", i)
+				body.Credentials.Password.Config = identity.AdminIdentityImportCredentialsPasswordConfig{HashedPassword: hashedPassword}
+				lines = append(lines, encode(t, body))
+				continue
+			}
+			failedLines = append(failedLines, int64(i+1))
+		}
+
+		t.Run("case=imports in batches and reports failed lines", func(t *testing.T) {
+			res := importNDJSON(t, "?batch_size=7", append(lines, ""), http.StatusOK)
+			assert.EqualValues(t, 60-len(failedLines), res.Get("imported").Int(), "%s", res.Raw)
+			assert.EqualValues(t, 0, res.Get("skipped").Int(), "%s", res.Raw)
+
+			var actual []int64
+			for _, l := range res.Get("failed.#.line").Array() {
+				actual = append(actual, l.Int())
+			}
+			assert.ElementsMatch(t, failedLines, actual, "%s", res.Raw)
+			assert.Equal(t, "Bad Request", res.Get("failed.#(line==31).error.status").String(), "%s", res.Raw)
+			assert.Equal(t, "Conflict", res.Get("failed.#(line==46).error.status").String(), "%s", res.Raw)
+
+			found, err := reg.PrivilegedIdentityPool().FindIdentityByCredentialIdentifier(ctx, "ndjson-import-0-0@ory.sh", false)
+			require.NoError(t, err)
+			imported, err := reg.PrivilegedIdentityPool().GetIdentityConfidential(ctx, found.ID)
+			require.NoError(t, err)
+			assert.Equal(t, hashedPassword, gjson.GetBytes(imported.Credentials[identity.CredentialsTypePassword].Config, "hashed_password").String())
+		})
+
+		t.Run("case=resumes from the offset", func(t *testing.T) {
+			resumed := append(lines, encode(t, validCreateIdentityBody("ndjson-import-resumed", 0)))
+			res := importNDJSON(t, fmt.Sprintf("?offset=%d", len(lines)), resumed, http.StatusOK)
+			assert.EqualValues(t, 1, res.Get("imported").Int(), "%s", res.Raw)
+			assert.EqualValues(t, len(lines), res.Get("skipped").Int(), "%s", res.Raw)
+			assert.Empty(t, res.Get("failed").Array(), "%s", res.Raw)
+		})
+
+		t.Run("case=rejects invalid batch sizes", func(t *testing.T) {
+			for _, q := range []string{"?batch_size=0", "?batch_size=-1", fmt.Sprintf("?batch_size=%d", identity.BatchPatchIdentitiesLimit+1), "?offset=abc"} {
+				res := importNDJSON(t, q, lines[:1], http.StatusBadRequest)
+				assert.NotEmpty(t, res.Get("error.reason").String(), q)
+			}
+		})
+	})
+
 	t.Run("case=PATCH update of state should update state changed at timestamp", func(t *testing.T) {
 		uuid := x.NewUUID().String()
 		email := "UPPER" + uuid + "@ory.sh"
@@ -0,0 +1,97 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"net/http"
+
+	"github.com/gofrs/uuid"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/pagination/keysetpagination"
+	"github.com/ory/x/pagination/migrationpagination"
+)
+
+// Paginated Identity Web Hook Delivery List Response
+//
+// swagger:response listIdentityWebHookDeliveries
+//
+//nolint:deadcode,unused
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type listIdentityWebHookDeliveriesResponse struct {
+	migrationpagination.ResponseHeaderAnnotation
+
+	// List of deliveries
+	//
+	// in:body
+	Body []WebHookDelivery
+}
+
+// List Identity Web Hook Deliveries Parameters
+//
+// swagger:parameters listIdentityWebHookDeliveries
+//
+//nolint:deadcode,unused
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type listIdentityWebHookDeliveries struct {
+	keysetpagination.RequestParameters
+	ListWebHookDeliveriesParameters
+}
+
+// swagger:route GET /admin/identity-webhook-deliveries identity listIdentityWebHookDeliveries
+//
+// # List Identity Web Hook Deliveries
+//
+// Lists the deliveries of the identity lifecycle web hooks configured in
+// `identity.webhooks`, newest first.
+//
+//	Produces:
+//	- application/json
+//
+//	Security:
+//	  oryAccessToken:
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: listIdentityWebHookDeliveries
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *Handler) listWebHookDeliveries(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var params ListWebHookDeliveriesParameters
+	if r.URL.Query().Has("identity_id") {
+		id, err := uuid.FromString(r.URL.Query().Get("identity_id"))
+		if err != nil {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Query parameter identity_id must be a UUID.")))
+			return
+		}
+		params.IdentityID = id
+	}
+	if r.URL.Query().Has("status") {
+		status, ok := ToWebHookDeliveryStatus(r.URL.Query().Get("status"))
+		if !ok {
+			h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Query parameter status must be one of queued, delivered, or abandoned.")))
+			return
+		}
+		params.Status = status
+	}
+
+	opts, err := keysetpagination.Parse(r.URL.Query(), keysetpagination.NewMapPageToken)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+
+	deliveries, nextPage, err := h.r.IdentityWebHookDeliveryPersister().ListWebHookDeliveries(r.Context(), params, opts)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	u := *r.URL
+	keysetpagination.Header(w, &u, nextPage)
+	h.r.Writer().Write(w, r, deliveries)
+}
@@ -13,6 +13,7 @@ import (
 
 	"github.com/ory/kratos/schema"
 	"github.com/ory/x/sqlcon"
+	"github.com/ory/x/sqlxx"
 
 	"github.com/ory/x/otelx"
 
@@ -20,6 +21,7 @@ import (
 
 	"github.com/ory/kratos/driver/config"
 
+	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
 
 	"github.com/mohae/deepcopy"
@@ -42,6 +44,8 @@ type (
 		courier.Provider
 		ValidationProvider
 		ActiveCredentialsCounterStrategyProvider
+		WebHookDeliveryPersistenceProvider
+		x.TransactionPersistenceProvider
 		x.LoggingProvider
 	}
 	ManagementProvider interface {
@@ -87,12 +91,21 @@ func (m *Manager) Create(ctx context.Context, i *Identity, opts ...ManagerOption
 		i.SchemaID = m.r.Config().DefaultIdentityTraitsSchemaID(ctx)
 	}
 
+	if err := m.applyDefaultMetadata(ctx, i); err != nil {
+		return err
+	}
+
 	o := newManagerOptions(opts)
 	if err := m.ValidateIdentity(ctx, i, o); err != nil {
 		return err
 	}
 
-	if err := m.r.PrivilegedIdentityPool().CreateIdentity(ctx, i); err != nil {
+	if err := m.transaction(ctx, func(ctx context.Context) error {
+		if err := m.r.PrivilegedIdentityPool().CreateIdentity(ctx, i); err != nil {
+			return err
+		}
+		return m.enqueueWebHookDeliveries(ctx, WebHookEventCreated, i)
+	}); err != nil {
 		if errors.Is(err, sqlcon.ErrUniqueViolation) {
 			return m.findExistingAuthMethod(ctx, err, i)
 		}
@@ -102,6 +115,73 @@ func (m *Manager) Create(ctx context.Context, i *Identity, opts ...ManagerOption
 	return nil
 }
 
+// transaction runs write in a transaction that the identity writes and the
+// queued identity web hook deliveries share.
+func (m *Manager) transaction(ctx context.Context, write func(ctx context.Context) error) error {
+	return m.r.TransactionalPersisterProvider().Transaction(ctx, func(ctx context.Context, _ *pop.Connection) error {
+		return write(ctx)
+	})
+}
+
+// applyDefaultMetadata deep-merges the configured default metadata into a new
+// identity. Values already set on the identity take precedence.
+func (m *Manager) applyDefaultMetadata(ctx context.Context, i *Identity) (err error) {
+	if i.MetadataPublic, err = mergeDefaultMetadata(m.r.Config().IdentityDefaultMetadataPublic(ctx), i.MetadataPublic); err != nil {
+		return err
+	}
+	if i.MetadataAdmin, err = mergeDefaultMetadata(m.r.Config().IdentityDefaultMetadataAdmin(ctx), i.MetadataAdmin); err != nil {
+		return err
+	}
+	return nil
+}
+
+func mergeDefaultMetadata(defaults map[string]any, metadata sqlxx.NullJSONRawMessage) (sqlxx.NullJSONRawMessage, error) {
+	if len(defaults) == 0 {
+		return metadata, nil
+	}
+
+	var values any
+	if len(metadata) > 0 {
+		if err := json.Unmarshal(metadata, &values); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	var merged map[string]any
+	switch v := values.(type) {
+	case nil:
+		merged = deepMergeMetadata(defaults, nil)
+	case map[string]any:
+		merged = deepMergeMetadata(defaults, v)
+	default:
+		// Metadata which is not an object can not be merged and is kept as is.
+		return metadata, nil
+	}
+
+	out, err := json.Marshal(merged)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return out, nil
+}
+
+func deepMergeMetadata(defaults, values map[string]any) map[string]any {
+	merged := make(map[string]any, len(defaults)+len(values))
+	for k, v := range defaults {
+		merged[k] = v
+	}
+	for k, v := range values {
+		if vm, ok := v.(map[string]any); ok {
+			if dm, ok := merged[k].(map[string]any); ok {
+				merged[k] = deepMergeMetadata(dm, vm)
+				continue
+			}
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
 func (m *Manager) ConflictingIdentity(ctx context.Context, i *Identity) (found *Identity, foundConflictAddress string, err error) {
 	for ct, cred := range i.Credentials {
 		for _, id := range cred.Identifiers {
@@ -392,6 +472,11 @@ func (m *Manager) CreateIdentities(ctx context.Context, identities []*Identity,
 			ident.SchemaID = m.r.Config().DefaultIdentityTraitsSchemaID(ctx)
 		}
 
+		if err := m.applyDefaultMetadata(ctx, ident); err != nil {
+			createIdentitiesError.AddFailedIdentity(ident, herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err))
+			continue
+		}
+
 		o := newManagerOptions(opts)
 		if err := m.ValidateIdentity(ctx, ident, o); err != nil {
 			createIdentitiesError.AddFailedIdentity(ident, herodot.ErrBadRequest.WithReasonf("%s", err).WithWrap(err))
@@ -400,7 +485,14 @@ func (m *Manager) CreateIdentities(ctx context.Context, identities []*Identity,
 		validIdentities = append(validIdentities, ident)
 	}
 
-	if err := m.r.PrivilegedIdentityPool().CreateIdentities(ctx, validIdentities...); err != nil {
+	if err := m.transaction(ctx, func(ctx context.Context) error {
+		// A partial error rolls back the whole batch, so deliveries are only
+		// queued if every identity was created.
+		if err := m.r.PrivilegedIdentityPool().CreateIdentities(ctx, validIdentities...); err != nil {
+			return err
+		}
+		return m.enqueueWebHookDeliveries(ctx, WebHookEventCreated, validIdentities...)
+	}); err != nil {
 		if partialErr := new(CreateIdentitiesError); errors.As(err, &partialErr) {
 			createIdentitiesError.Merge(partialErr)
 		} else {
@@ -452,7 +544,38 @@ func (m *Manager) Update(ctx context.Context, updated *Identity, opts ...Manager
 		return err
 	}
 
-	return m.r.PrivilegedIdentityPool().UpdateIdentity(ctx, updated)
+	return m.transaction(ctx, func(ctx context.Context) error {
+		if err := m.r.PrivilegedIdentityPool().UpdateIdentity(ctx, updated); err != nil {
+			return err
+		}
+		return m.enqueueWebHookDeliveries(ctx, WebHookEventUpdated, updated)
+	})
+}
+
+// Delete deletes the identity and notifies the identity web hooks.
+func (m *Manager) Delete(ctx context.Context, id uuid.UUID) (err error) {
+	ctx, span := m.r.Tracer(ctx).Tracer().Start(ctx, "identity.Manager.Delete")
+	defer otelx.End(span, &err)
+
+	// The identity is only loaded if a web hook needs its last state.
+	var original *Identity
+	if hooks, err := m.r.Config().IdentityWebHooks(ctx); err != nil {
+		return err
+	} else if len(hooks) > 0 {
+		if original, err = m.r.PrivilegedIdentityPool().GetIdentity(ctx, id, ExpandDefault); err != nil {
+			return err
+		}
+	}
+
+	return m.transaction(ctx, func(ctx context.Context) error {
+		if err := m.r.PrivilegedIdentityPool().DeleteIdentity(ctx, id); err != nil {
+			return err
+		}
+		if original == nil {
+			return nil
+		}
+		return m.enqueueWebHookDeliveries(ctx, WebHookEventDeleted, original)
+	})
 }
 
 func (m *Manager) UpdateSchemaID(ctx context.Context, id uuid.UUID, schemaID string, opts ...ManagerOption) (err error) {
@@ -474,7 +597,12 @@ func (m *Manager) UpdateSchemaID(ctx context.Context, id uuid.UUID, schemaID str
 		return err
 	}
 
-	return m.r.PrivilegedIdentityPool().UpdateIdentity(ctx, original)
+	return m.transaction(ctx, func(ctx context.Context) error {
+		if err := m.r.PrivilegedIdentityPool().UpdateIdentity(ctx, original); err != nil {
+			return err
+		}
+		return m.enqueueWebHookDeliveries(ctx, WebHookEventUpdated, original)
+	})
 }
 
 func (m *Manager) SetTraits(ctx context.Context, id uuid.UUID, traits Traits, opts ...ManagerOption) (_ *Identity, err error) {
@@ -534,7 +662,12 @@ func (m *Manager) UpdateTraits(ctx context.Context, id uuid.UUID, traits Traits,
 		return err
 	}
 
-	return m.r.PrivilegedIdentityPool().UpdateIdentity(ctx, updated)
+	return m.transaction(ctx, func(ctx context.Context) error {
+		if err := m.r.PrivilegedIdentityPool().UpdateIdentity(ctx, updated); err != nil {
+			return err
+		}
+		return m.enqueueWebHookDeliveries(ctx, WebHookEventUpdated, updated)
+	})
 }
 
 func (m *Manager) ValidateIdentity(ctx context.Context, i *Identity, o *ManagerOptions) (err error) {
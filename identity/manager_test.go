@@ -119,6 +119,64 @@ func TestManager(t *testing.T) {
 			assert.Equal(t, identity.NoAuthenticatorAssuranceLevel, got)
 		})
 
+		t.Run("case=should merge default metadata", func(t *testing.T) {
+			conf.MustSet(ctx, config.ViperKeyIdentityDefaultMetadataPublic, map[string]any{"org": "acme", "plan": "pro", "limits": map[string]any{"seats": 5, "projects": 1}})
+			conf.MustSet(ctx, config.ViperKeyIdentityDefaultMetadataAdmin, map[string]any{"tier": "gold"})
+			t.Cleanup(func() {
+				conf.MustSet(ctx, config.ViperKeyIdentityDefaultMetadataPublic, nil)
+				conf.MustSet(ctx, config.ViperKeyIdentityDefaultMetadataAdmin, nil)
+			})
+
+			t.Run("case=defaults are applied to identities without metadata", func(t *testing.T) {
+				original := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+				original.Traits = newTraits(uuid.Must(uuid.NewV4()).String()+"@ory.sh", "")
+				require.NoError(t, reg.IdentityManager().Create(ctx, original))
+
+				fromStore, err := reg.PrivilegedIdentityPool().GetIdentity(ctx, original.ID, identity.ExpandNothing)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"org":"acme","plan":"pro","limits":{"seats":5,"projects":1}}`, string(fromStore.MetadataPublic))
+				assert.JSONEq(t, `{"tier":"gold"}`, string(fromStore.MetadataAdmin))
+			})
+
+			t.Run("case=request values win over defaults", func(t *testing.T) {
+				original := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+				original.Traits = newTraits(uuid.Must(uuid.NewV4()).String()+"@ory.sh", "")
+				original.MetadataPublic = sqlxx.NullJSONRawMessage(`{"plan":"free","limits":{"seats":1},"extra":true}`)
+				original.MetadataAdmin = sqlxx.NullJSONRawMessage(`["not", "an", "object"]`)
+				require.NoError(t, reg.IdentityManager().Create(ctx, original))
+
+				fromStore, err := reg.PrivilegedIdentityPool().GetIdentity(ctx, original.ID, identity.ExpandNothing)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"org":"acme","plan":"free","limits":{"seats":1,"projects":1},"extra":true}`, string(fromStore.MetadataPublic))
+				assert.JSONEq(t, `["not", "an", "object"]`, string(fromStore.MetadataAdmin))
+			})
+
+			t.Run("case=defaults are applied when creating identities in batch", func(t *testing.T) {
+				original := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+				original.Traits = newTraits(uuid.Must(uuid.NewV4()).String()+"@ory.sh", "")
+				require.NoError(t, reg.IdentityManager().CreateIdentities(ctx, []*identity.Identity{original}))
+
+				fromStore, err := reg.PrivilegedIdentityPool().GetIdentity(ctx, original.ID, identity.ExpandNothing)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"org":"acme","plan":"pro","limits":{"seats":5,"projects":1}}`, string(fromStore.MetadataPublic))
+			})
+
+			t.Run("case=defaults are not applied on update", func(t *testing.T) {
+				original := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+				original.Traits = newTraits(uuid.Must(uuid.NewV4()).String()+"@ory.sh", "")
+				require.NoError(t, reg.IdentityManager().Create(ctx, original))
+
+				original.MetadataPublic = sqlxx.NullJSONRawMessage(`{"plan":"enterprise"}`)
+				original.MetadataAdmin = nil
+				require.NoError(t, reg.IdentityManager().Update(ctx, original, identity.ManagerAllowWriteProtectedTraits))
+
+				fromStore, err := reg.PrivilegedIdentityPool().GetIdentity(ctx, original.ID, identity.ExpandNothing)
+				require.NoError(t, err)
+				assert.JSONEq(t, `{"plan":"enterprise"}`, string(fromStore.MetadataPublic))
+				assert.JSONEq(t, `null`, string(fromStore.MetadataAdmin))
+			})
+		})
+
 		t.Run("case=correctly set AAL", func(t *testing.T) {
 			t.Run("case=should set AAL to 0 if no credentials are available", func(t *testing.T) {
 				email := uuid.Must(uuid.NewV4()).String() + "@ory.sh"
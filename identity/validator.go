@@ -60,7 +60,9 @@ func (v *Validator) ValidateWithRunner(ctx context.Context, i *Identity, runners
 		return errors.WithStack(herodot.ErrBadRequest.WithError(err.Error()))
 	}
 
-	return v.v.Validate(ctx, s.URL.String(), traits, schema.WithExtensionRunner(runner))
+	return v.v.Validate(ctx, s.URL.String(), traits,
+		schema.WithExtensionRunner(runner),
+		schema.WithRemoteSchemaCache(v.d.Config().IdentitySchemaCache(ctx)))
 }
 
 func (v *Validator) Validate(ctx context.Context, i *Identity) error {
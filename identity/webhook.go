@@ -0,0 +1,209 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"slices"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/pagination/keysetpagination"
+	"github.com/ory/x/sqlxx"
+)
+
+// An identity lifecycle event
+//
+// swagger:enum WebHookEvent
+type WebHookEvent string
+
+const (
+	WebHookEventCreated WebHookEvent = "identity.created"
+	WebHookEventUpdated WebHookEvent = "identity.updated"
+	WebHookEventDeleted WebHookEvent = "identity.deleted"
+)
+
+// The status of an identity web hook delivery
+//
+// swagger:enum WebHookDeliveryStatus
+type WebHookDeliveryStatus string
+
+const (
+	WebHookDeliveryStatusQueued    WebHookDeliveryStatus = "queued"
+	WebHookDeliveryStatusDelivered WebHookDeliveryStatus = "delivered"
+	WebHookDeliveryStatusAbandoned WebHookDeliveryStatus = "abandoned"
+)
+
+type (
+	// Identity Web Hook Delivery
+	//
+	// A queued or completed notification of an identity lifecycle web hook.
+	//
+	// swagger:model identityWebHookDelivery
+	WebHookDelivery struct {
+		// required: true
+		ID uuid.UUID `json:"id" db:"id"`
+
+		// The ID of the web hook in `identity.webhooks`.
+		//
+		// required: true
+		WebHookID string `json:"webhook_id" db:"webhook_id"`
+
+		// required: true
+		IdentityID uuid.UUID `json:"identity_id" db:"identity_id"`
+
+		// The lifecycle event that was delivered.
+		//
+		// required: true
+		Event WebHookEvent `json:"event" db:"event"`
+
+		// The status of the delivery.
+		//
+		// required: true
+		Status WebHookDeliveryStatus `json:"status" db:"status"`
+
+		// How often the delivery was attempted.
+		//
+		// required: true
+		Attempts int `json:"attempts" db:"attempts"`
+
+		// When the delivery is attempted next, if it is still queued.
+		//
+		// required: true
+		NextAttemptAt time.Time `json:"next_attempt_at" db:"next_attempt_at"`
+
+		// The error of the last failed attempt.
+		LastError sqlxx.NullString `json:"last_error,omitempty" db:"last_error"`
+
+		// The identity as it was when the event occurred.
+		Payload sqlxx.JSONRawMessage `json:"-" db:"payload"`
+
+		NID       uuid.UUID `json:"-" faker:"-" db:"nid"`
+		CreatedAt time.Time `json:"created_at" db:"created_at"`
+		UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+	}
+
+	ListWebHookDeliveriesParameters struct {
+		// Only returns deliveries for this identity.
+		//
+		// in: query
+		IdentityID uuid.UUID `json:"identity_id"`
+
+		// Only returns deliveries with this status.
+		//
+		// in: query
+		Status WebHookDeliveryStatus `json:"status"`
+	}
+
+	WebHookDeliveryPersister interface {
+		CreateWebHookDeliveries(ctx context.Context, deliveries ...*WebHookDelivery) error
+
+		// NextWebHookDeliveries returns queued deliveries which are due at
+		// now. A delivery is only returned once all earlier deliveries for the
+		// same identity and web hook are no longer queued.
+		NextWebHookDeliveries(ctx context.Context, now time.Time, limit int) ([]WebHookDelivery, error)
+
+		// ClaimWebHookDelivery increments the attempts of a due delivery and
+		// postpones it until leaseUntil, so that no other worker attempts it
+		// concurrently. It reports false if the delivery was no longer due.
+		ClaimWebHookDelivery(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (bool, error)
+
+		UpdateWebHookDelivery(ctx context.Context, delivery *WebHookDelivery) error
+		ListWebHookDeliveries(ctx context.Context, params ListWebHookDeliveriesParameters, opts []keysetpagination.Option) ([]WebHookDelivery, *keysetpagination.Paginator, error)
+
+		// DeleteExpiredWebHookDeliveries deletes up to limit delivered or
+		// abandoned deliveries created before the given time.
+		DeleteExpiredWebHookDeliveries(ctx context.Context, before time.Time, limit int) error
+	}
+
+	WebHookDeliveryPersistenceProvider interface {
+		IdentityWebHookDeliveryPersister() WebHookDeliveryPersister
+	}
+)
+
+func (d WebHookDelivery) TableName(context.Context) string {
+	return "identity_webhook_deliveries"
+}
+
+func (d *WebHookDelivery) GetID() uuid.UUID {
+	return d.ID
+}
+
+func (d *WebHookDelivery) GetNID() uuid.UUID {
+	return d.NID
+}
+
+func (d WebHookDelivery) PageToken() keysetpagination.PageToken {
+	return keysetpagination.MapPageToken{
+		"id":         d.ID.String(),
+		"created_at": d.CreatedAt.Format(x.MapPaginationDateFormat),
+	}
+}
+
+func (d WebHookDelivery) DefaultPageToken() keysetpagination.PageToken {
+	return keysetpagination.MapPageToken{
+		"id":         uuid.Nil.String(),
+		"created_at": time.Date(2200, 12, 31, 23, 59, 59, 0, time.UTC).Format(x.MapPaginationDateFormat),
+	}
+}
+
+// ToWebHookDeliveryStatus validates a delivery status from a query parameter.
+func ToWebHookDeliveryStatus(s string) (WebHookDeliveryStatus, bool) {
+	switch status := WebHookDeliveryStatus(s); status {
+	case WebHookDeliveryStatusQueued, WebHookDeliveryStatusDelivered, WebHookDeliveryStatusAbandoned:
+		return status, true
+	}
+	return "", false
+}
+
+func webHookSubscribes(hook *config.IdentityWebHook, event WebHookEvent) bool {
+	return len(hook.Events) == 0 || slices.Contains(hook.Events, string(event))
+}
+
+// enqueueWebHookDeliveries queues a delivery of event for every identity to
+// every web hook subscribed to it. Call it in the transaction that writes the
+// identities, so that a delivery is queued if and only if the write commits.
+func (m *Manager) enqueueWebHookDeliveries(ctx context.Context, event WebHookEvent, identities ...*Identity) error {
+	hooks, err := m.r.Config().IdentityWebHooks(ctx)
+	if err != nil {
+		return err
+	} else if len(hooks) == 0 || len(identities) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	var deliveries []*WebHookDelivery
+	for _, i := range identities {
+		payload, err := json.Marshal(WithCredentialsMetadataAndAdminMetadataInJSON(*i))
+		if err != nil {
+			return errors.WithStack(err)
+		}
+
+		for _, hook := range hooks {
+			if !webHookSubscribes(hook, event) {
+				continue
+			}
+			deliveries = append(deliveries, &WebHookDelivery{
+				ID:            x.NewUUID(),
+				WebHookID:     hook.ID,
+				IdentityID:    i.ID,
+				Event:         event,
+				Status:        WebHookDeliveryStatusQueued,
+				NextAttemptAt: now,
+				Payload:       payload,
+			})
+		}
+	}
+
+	if len(deliveries) == 0 {
+		return nil
+	}
+
+	return m.r.IdentityWebHookDeliveryPersister().CreateWebHookDeliveries(ctx, deliveries...)
+}
@@ -0,0 +1,215 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package identity
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/request"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/jsonnetsecure"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlxx"
+)
+
+const (
+	// WebHookMaxAttempts is how often a delivery is attempted before it is
+	// abandoned.
+	WebHookMaxAttempts = 10
+
+	webHookBatchSize    = 50
+	webHookPullWait     = time.Second
+	webHookLease        = 5 * time.Minute
+	webHookMaxBackoff   = time.Hour
+	webHookPassThrough  = "base64://ZnVuY3Rpb24oY3R4KSBjdHg=" // function(ctx) ctx
+	webHookErrorMaxSize = 1024
+)
+
+type (
+	webHookDispatcherDependencies interface {
+		config.Provider
+		x.LoggingProvider
+		x.TracingProvider
+		x.HTTPClientProvider
+		jsonnetsecure.VMProvider
+		WebHookDeliveryPersistenceProvider
+	}
+	WebHookDispatcher struct {
+		r webHookDispatcherDependencies
+	}
+	WebHookDispatcherProvider interface {
+		IdentityWebHookDispatcher() *WebHookDispatcher
+	}
+
+	// webHookRequestBody is passed as `ctx` to the Jsonnet body template of
+	// the web hook. Without a body template it is sent as is.
+	webHookRequestBody struct {
+		DeliveryID uuid.UUID       `json:"delivery_id"`
+		Event      WebHookEvent    `json:"event"`
+		Identity   json.RawMessage `json:"identity"`
+		OccurredAt time.Time       `json:"occurred_at"`
+	}
+)
+
+func NewWebHookDispatcher(r webHookDispatcherDependencies) *WebHookDispatcher {
+	return &WebHookDispatcher{r: r}
+}
+
+// Work dispatches due deliveries until ctx is canceled.
+func (d *WebHookDispatcher) Work(ctx context.Context) error {
+	for {
+		if err := d.DispatchQueue(ctx); err != nil {
+			d.r.Logger().WithError(err).Error("Unable to dispatch identity web hook deliveries.")
+		}
+
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.Canceled) {
+				return nil
+			}
+			return ctx.Err()
+		case <-time.After(webHookPullWait):
+		}
+	}
+}
+
+// DispatchQueue attempts all deliveries which are currently due.
+func (d *WebHookDispatcher) DispatchQueue(ctx context.Context) (err error) {
+	ctx, span := d.r.Tracer(ctx).Tracer().Start(ctx, "identity.WebHookDispatcher.DispatchQueue")
+	defer otelx.End(span, &err)
+
+	// Without web hooks there is nothing to deliver to. Deliveries queued
+	// earlier wait until web hooks are configured again.
+	hooks, err := d.r.Config().IdentityWebHooks(ctx)
+	if err != nil {
+		return err
+	} else if len(hooks) == 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	deliveries, err := d.r.IdentityWebHookDeliveryPersister().NextWebHookDeliveries(ctx, now, webHookBatchSize)
+	if err != nil {
+		return err
+	}
+
+	for k := range deliveries {
+		delivery := &deliveries[k]
+		claimed, err := d.r.IdentityWebHookDeliveryPersister().ClaimWebHookDelivery(ctx, delivery.ID, now, now.Add(webHookLease))
+		if err != nil {
+			return err
+		} else if !claimed {
+			continue
+		}
+		delivery.Attempts++
+
+		logger := d.r.Logger().
+			WithField("delivery_id", delivery.ID).
+			WithField("webhook_id", delivery.WebHookID).
+			WithField("identity_id", delivery.IdentityID).
+			WithField("event", delivery.Event)
+
+		switch err := d.deliver(ctx, hooks, delivery); {
+		case err == nil:
+			delivery.Status = WebHookDeliveryStatusDelivered
+			delivery.LastError = ""
+			logger.Debug("Delivered identity web hook.")
+		case delivery.Attempts >= WebHookMaxAttempts || errors.Is(err, errWebHookNotConfigured):
+			delivery.Status = WebHookDeliveryStatusAbandoned
+			delivery.LastError = webHookError(err)
+			logger.WithError(err).Error("Abandoned identity web hook delivery.")
+		default:
+			delivery.NextAttemptAt = now.Add(webHookBackoff(delivery.Attempts))
+			delivery.LastError = webHookError(err)
+			logger.WithError(err).Warn("Unable to deliver identity web hook, retrying later.")
+		}
+
+		if err := d.r.IdentityWebHookDeliveryPersister().UpdateWebHookDelivery(ctx, delivery); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var errWebHookNotConfigured = errors.New("the web hook is no longer configured")
+
+func (d *WebHookDispatcher) deliver(ctx context.Context, hooks []*config.IdentityWebHook, delivery *WebHookDelivery) (err error) {
+	ctx, span := d.r.Tracer(ctx).Tracer().Start(ctx, "identity.WebHookDispatcher.deliver")
+	defer otelx.End(span, &err)
+
+	var hook *config.IdentityWebHook
+	for _, h := range hooks {
+		if h.ID == delivery.WebHookID {
+			hook = h
+			break
+		}
+	}
+	if hook == nil {
+		return errors.WithStack(errWebHookNotConfigured)
+	}
+
+	requestConfig := hook.RequestConfig
+	if !gjson.GetBytes(requestConfig, "method").Exists() {
+		if requestConfig, err = sjson.SetBytes(requestConfig, "method", "POST"); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+	if !gjson.GetBytes(requestConfig, "body").Exists() {
+		if requestConfig, err = sjson.SetBytes(requestConfig, "body", webHookPassThrough); err != nil {
+			return errors.WithStack(err)
+		}
+	}
+
+	builder, err := request.NewBuilder(ctx, requestConfig, d.r, nil)
+	if err != nil {
+		return err
+	}
+
+	req, err := builder.BuildRequest(ctx, &webHookRequestBody{
+		DeliveryID: delivery.ID,
+		Event:      delivery.Event,
+		Identity:   json.RawMessage(delivery.Payload),
+		OccurredAt: delivery.CreatedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	res, err := d.r.HTTPClient(ctx).Do(req.WithContext(ctx))
+	if err != nil {
+		return errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode >= 300 {
+		return errors.Errorf("web hook responded with status code %d", res.StatusCode)
+	}
+	return nil
+}
+
+// webHookBackoff doubles the wait after every failed attempt, starting at one
+// second and capped at one hour.
+func webHookBackoff(attempts int) time.Duration {
+	if attempts > 12 {
+		return webHookMaxBackoff
+	}
+	return min(time.Duration(1<<(attempts-1))*time.Second, webHookMaxBackoff)
+}
+
+func webHookError(err error) sqlxx.NullString {
+	msg := err.Error()
+	if len(msg) > webHookErrorMaxSize {
+		msg = msg[:webHookErrorMaxSize]
+	}
+	return sqlxx.NullString(msg)
+}
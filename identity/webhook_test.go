@@ -0,0 +1,191 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package identity_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/internal"
+	"github.com/ory/kratos/internal/testhelpers"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/configx"
+)
+
+func TestWebHookDeliveries(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t, configx.WithValues(testhelpers.DefaultIdentitySchemaConfig("file://./stub/identity.schema.json")))
+	_, adminTS := testhelpers.NewKratosServer(t, reg)
+
+	type received struct {
+		hook  string
+		event string
+		body  []byte
+	}
+	var (
+		mu        sync.Mutex
+		failures  = map[string]int{}
+		delivered []received
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		mu.Lock()
+		defer mu.Unlock()
+		hook := r.URL.Path[1:]
+		if failures[hook] > 0 {
+			failures[hook]--
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		delivered = append(delivered, received{hook: hook, event: gjson.GetBytes(body, "event").String(), body: body})
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(ts.Close)
+
+	reset := func(t *testing.T, failing map[string]int) {
+		mu.Lock()
+		defer mu.Unlock()
+		failures, delivered = failing, nil
+	}
+	deliveredTo := func(hook string) (events []string, bodies [][]byte) {
+		mu.Lock()
+		defer mu.Unlock()
+		for _, d := range delivered {
+			if d.hook == hook {
+				events = append(events, d.event)
+				bodies = append(bodies, d.body)
+			}
+		}
+		return
+	}
+	dispatchUntil := func(t *testing.T, done func() bool) {
+		require.Eventually(t, func() bool {
+			require.NoError(t, reg.IdentityWebHookDispatcher().DispatchQueue(ctx))
+			return done()
+		}, 10*time.Second, 100*time.Millisecond)
+	}
+	listDeliveries := func(t *testing.T, id string) (deliveries []identity.WebHookDelivery) {
+		res, err := adminTS.Client().Get(adminTS.URL + x.AdminPrefix + identity.RouteWebHookDeliveries + "?identity_id=" + id)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.NoError(t, json.NewDecoder(res.Body).Decode(&deliveries))
+		return deliveries
+	}
+
+	conf.MustSet(ctx, config.ViperKeyIdentityWebHooks, []map[string]any{
+		{"id": "crm", "request_config": map[string]any{"url": ts.URL + "/crm"}},
+		{"id": "audit", "events": []string{"identity.deleted"}, "request_config": map[string]any{"url": ts.URL + "/audit"}},
+	})
+
+	t.Run("case=retries failed deliveries", func(t *testing.T) {
+		reset(t, map[string]int{"crm": 1})
+
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = identity.Traits(`{"email":"retry@ory.sh"}`)
+		require.NoError(t, reg.IdentityManager().Create(ctx, i))
+
+		require.NoError(t, reg.IdentityWebHookDispatcher().DispatchQueue(ctx))
+		deliveries := listDeliveries(t, i.ID.String())
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, identity.WebHookDeliveryStatusQueued, deliveries[0].Status)
+		assert.Equal(t, 1, deliveries[0].Attempts)
+		assert.Contains(t, string(deliveries[0].LastError), "status code 400")
+
+		dispatchUntil(t, func() bool {
+			events, _ := deliveredTo("crm")
+			return len(events) == 1
+		})
+
+		_, bodies := deliveredTo("crm")
+		assert.Equal(t, i.ID.String(), gjson.GetBytes(bodies[0], "identity.id").String(), "%s", bodies[0])
+		assert.Equal(t, "retry@ory.sh", gjson.GetBytes(bodies[0], "identity.traits.email").String(), "%s", bodies[0])
+		assert.False(t, gjson.GetBytes(bodies[0], "identity.credentials.password.config").Exists(), "%s", bodies[0])
+		assert.Equal(t, deliveries[0].ID.String(), gjson.GetBytes(bodies[0], "delivery_id").String())
+
+		deliveries = listDeliveries(t, i.ID.String())
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, identity.WebHookDeliveryStatusDelivered, deliveries[0].Status)
+		assert.Equal(t, 2, deliveries[0].Attempts)
+	})
+
+	t.Run("case=delivers events of an identity in order", func(t *testing.T) {
+		reset(t, map[string]int{"crm": 2})
+
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = identity.Traits(`{"email":"order@ory.sh"}`)
+		require.NoError(t, reg.IdentityManager().Create(ctx, i))
+		require.NoError(t, reg.IdentityManager().UpdateTraits(ctx, i.ID, identity.Traits(`{"email":"order@ory.sh","bar":"baz"}`), identity.ManagerAllowWriteProtectedTraits))
+		require.NoError(t, reg.IdentityManager().Delete(ctx, i.ID))
+
+		dispatchUntil(t, func() bool {
+			events, _ := deliveredTo("crm")
+			return len(events) == 3
+		})
+
+		events, _ := deliveredTo("crm")
+		assert.Equal(t, []string{"identity.created", "identity.updated", "identity.deleted"}, events)
+
+		// The failing web hook did not hold back the other one.
+		events, bodies := deliveredTo("audit")
+		assert.Equal(t, []string{"identity.deleted"}, events)
+		assert.Equal(t, "baz", gjson.GetBytes(bodies[0], "identity.traits.bar").String(), "%s", bodies[0])
+	})
+
+	t.Run("case=deletes expired deliveries", func(t *testing.T) {
+		reset(t, nil)
+
+		delivered := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		delivered.Traits = identity.Traits(`{"email":"expired@ory.sh"}`)
+		require.NoError(t, reg.IdentityManager().Create(ctx, delivered))
+		dispatchUntil(t, func() bool {
+			events, _ := deliveredTo("crm")
+			return len(events) == 1
+		})
+
+		queued := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		queued.Traits = identity.Traits(`{"email":"queued@ory.sh"}`)
+		require.NoError(t, reg.IdentityManager().Create(ctx, queued))
+
+		require.NoError(t, reg.IdentityWebHookDeliveryPersister().DeleteExpiredWebHookDeliveries(ctx, time.Now().Add(time.Minute), 100))
+		assert.Empty(t, listDeliveries(t, delivered.ID.String()))
+		assert.Len(t, listDeliveries(t, queued.ID.String()), 1)
+	})
+
+	t.Run("case=keeps deliveries queued without web hooks", func(t *testing.T) {
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = identity.Traits(`{"email":"idle@ory.sh"}`)
+		require.NoError(t, reg.IdentityManager().Create(ctx, i))
+
+		conf.MustSet(ctx, config.ViperKeyIdentityWebHooks, nil)
+		require.NoError(t, reg.IdentityWebHookDispatcher().DispatchQueue(ctx))
+
+		deliveries := listDeliveries(t, i.ID.String())
+		require.Len(t, deliveries, 1)
+		assert.Equal(t, identity.WebHookDeliveryStatusQueued, deliveries[0].Status)
+		assert.Zero(t, deliveries[0].Attempts)
+	})
+
+	t.Run("case=does not queue deliveries without web hooks", func(t *testing.T) {
+
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.Traits = identity.Traits(`{"email":"none@ory.sh"}`)
+		require.NoError(t, reg.IdentityManager().Create(ctx, i))
+		assert.Empty(t, listDeliveries(t, i.ID.String()))
+	})
+}
@@ -114,7 +114,7 @@ type IdentityAPI interface {
 		You cannot delete password or code auth credentials through this API.
 			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
 			 * @param id ID is the identity's ID.
-			 * @param type_ Type is the type of credentials to delete. password CredentialsTypePassword oidc CredentialsTypeOIDC totp CredentialsTypeTOTP lookup_secret CredentialsTypeLookup webauthn CredentialsTypeWebAuthn code CredentialsTypeCodeAuth passkey CredentialsTypePasskey profile CredentialsTypeProfile link_recovery CredentialsTypeRecoveryLink  CredentialsTypeRecoveryLink is a special credential type linked to the link strategy (recovery flow).  It is not used within the credentials object itself. code_recovery CredentialsTypeRecoveryCode
+			 * @param type_ Type is the type of credentials to delete. password CredentialsTypePassword oidc CredentialsTypeOIDC totp CredentialsTypeTOTP lookup_secret CredentialsTypeLookup webauthn CredentialsTypeWebAuthn code CredentialsTypeCodeAuth passkey CredentialsTypePasskey profile CredentialsTypeProfile link_recovery CredentialsTypeRecoveryLink  CredentialsTypeRecoveryLink is a special credential type linked to the link strategy (recovery flow).  It is not used within the credentials object itself. code_recovery CredentialsTypeRecoveryCode impersonation CredentialsTypeImpersonation  CredentialsTypeImpersonation is the authentication method of sessions issued by the admin impersonation API.  It is not used within the credentials object itself.
 			 * @return IdentityAPIApiDeleteIdentityCredentialsRequest
 	*/
 	DeleteIdentityCredentials(ctx context.Context, id string, type_ string) IdentityAPIApiDeleteIdentityCredentialsRequest
@@ -225,6 +225,42 @@ type IdentityAPI interface {
 	 */
 	GetSessionExecute(r IdentityAPIApiGetSessionRequest) (*Session, *http.Response, error)
 
+	/*
+			 * ImpersonateIdentity Impersonate an Identity
+			 * Issues a session token for the identity to support staff. The session expires after
+		`session.impersonation.lifespan`, can not be extended, and can not be used to update
+		the identity's settings. The impersonator is returned as `impersonation` by the session
+		endpoints, including `/sessions/whoami`, and is written to the audit log.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return IdentityAPIApiImpersonateIdentityRequest
+	*/
+	ImpersonateIdentity(ctx context.Context) IdentityAPIApiImpersonateIdentityRequest
+
+	/*
+	 * ImpersonateIdentityExecute executes the request
+	 * @return ImpersonateIdentityResponse
+	 */
+	ImpersonateIdentityExecute(r IdentityAPIApiImpersonateIdentityRequest) (*ImpersonateIdentityResponse, *http.Response, error)
+
+	/*
+			 * ImportIdentities Import identities from newline-delimited JSON
+			 * Streams identities from the request body, one createIdentityBody per
+		line, and creates them in batches. Credentials can be imported the same
+		way as with the create identity endpoint, including hashed passwords.
+
+		Lines which fail to parse, validate, or insert are reported with their
+		line number and do not abort the import.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return IdentityAPIApiImportIdentitiesRequest
+	*/
+	ImportIdentities(ctx context.Context) IdentityAPIApiImportIdentitiesRequest
+
+	/*
+	 * ImportIdentitiesExecute executes the request
+	 * @return ImportIdentitiesResponse
+	 */
+	ImportIdentitiesExecute(r IdentityAPIApiImportIdentitiesRequest) (*ImportIdentitiesResponse, *http.Response, error)
+
 	/*
 	 * ListIdentities List Identities
 	 * Lists all [identities](https://www.ory.sh/docs/kratos/concepts/identity-user-model) in the system.
@@ -268,6 +304,21 @@ type IdentityAPI interface {
 	 */
 	ListIdentitySessionsExecute(r IdentityAPIApiListIdentitySessionsRequest) ([]Session, *http.Response, error)
 
+	/*
+			 * ListIdentityWebHookDeliveries List Identity Web Hook Deliveries
+			 * Lists the deliveries of the identity lifecycle web hooks configured in
+		`identity.webhooks`, newest first.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return IdentityAPIApiListIdentityWebHookDeliveriesRequest
+	*/
+	ListIdentityWebHookDeliveries(ctx context.Context) IdentityAPIApiListIdentityWebHookDeliveriesRequest
+
+	/*
+	 * ListIdentityWebHookDeliveriesExecute executes the request
+	 * @return []IdentityWebHookDelivery
+	 */
+	ListIdentityWebHookDeliveriesExecute(r IdentityAPIApiListIdentityWebHookDeliveriesRequest) ([]IdentityWebHookDelivery, *http.Response, error)
+
 	/*
 	 * ListSessions List All Sessions
 	 * Listing all sessions that exist.
@@ -313,6 +364,22 @@ type IdentityAPI interface {
 	 * @return Identity
 	 */
 	UpdateIdentityExecute(r IdentityAPIApiUpdateIdentityRequest) (*Identity, *http.Response, error)
+
+	/*
+			 * ValidatePasswordPolicy Validate a Password Against the Password Policy
+			 * Checks a sample password against the password policy of the
+		`selfservice.methods.password.config` section, including the
+		haveibeenpwned check if it is enabled. Nothing is stored.
+			 * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+			 * @return IdentityAPIApiValidatePasswordPolicyRequest
+	*/
+	ValidatePasswordPolicy(ctx context.Context) IdentityAPIApiValidatePasswordPolicyRequest
+
+	/*
+	 * ValidatePasswordPolicyExecute executes the request
+	 * @return PasswordPolicyValidation
+	 */
+	ValidatePasswordPolicyExecute(r IdentityAPIApiValidatePasswordPolicyRequest) (*PasswordPolicyValidation, *http.Response, error)
 }
 
 // IdentityAPIService IdentityAPI service
@@ -1090,7 +1157,7 @@ func (r IdentityAPIApiDeleteIdentityCredentialsRequest) Execute() (*http.Respons
 You cannot delete password or code auth credentials through this API.
   - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
   - @param id ID is the identity's ID.
-  - @param type_ Type is the type of credentials to delete. password CredentialsTypePassword oidc CredentialsTypeOIDC totp CredentialsTypeTOTP lookup_secret CredentialsTypeLookup webauthn CredentialsTypeWebAuthn code CredentialsTypeCodeAuth passkey CredentialsTypePasskey profile CredentialsTypeProfile link_recovery CredentialsTypeRecoveryLink  CredentialsTypeRecoveryLink is a special credential type linked to the link strategy (recovery flow).  It is not used within the credentials object itself. code_recovery CredentialsTypeRecoveryCode
+  - @param type_ Type is the type of credentials to delete. password CredentialsTypePassword oidc CredentialsTypeOIDC totp CredentialsTypeTOTP lookup_secret CredentialsTypeLookup webauthn CredentialsTypeWebAuthn code CredentialsTypeCodeAuth passkey CredentialsTypePasskey profile CredentialsTypeProfile link_recovery CredentialsTypeRecoveryLink  CredentialsTypeRecoveryLink is a special credential type linked to the link strategy (recovery flow).  It is not used within the credentials object itself. code_recovery CredentialsTypeRecoveryCode impersonation CredentialsTypeImpersonation  CredentialsTypeImpersonation is the authentication method of sessions issued by the admin impersonation API.  It is not used within the credentials object itself.
   - @return IdentityAPIApiDeleteIdentityCredentialsRequest
 */
 func (a *IdentityAPIService) DeleteIdentityCredentials(ctx context.Context, id string, type_ string) IdentityAPIApiDeleteIdentityCredentialsRequest {
@@ -2075,69 +2142,33 @@ func (a *IdentityAPIService) GetSessionExecute(r IdentityAPIApiGetSessionRequest
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type IdentityAPIApiListIdentitiesRequest struct {
-	ctx                                 context.Context
-	ApiService                          IdentityAPI
-	perPage                             *int64
-	page                                *int64
-	pageSize                            *int64
-	pageToken                           *string
-	consistency                         *string
-	ids                                 *[]string
-	credentialsIdentifier               *string
-	previewCredentialsIdentifierSimilar *string
-	includeCredential                   *[]string
+type IdentityAPIApiImpersonateIdentityRequest struct {
+	ctx                     context.Context
+	ApiService              IdentityAPI
+	impersonateIdentityBody *ImpersonateIdentityBody
 }
 
-func (r IdentityAPIApiListIdentitiesRequest) PerPage(perPage int64) IdentityAPIApiListIdentitiesRequest {
-	r.perPage = &perPage
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) Page(page int64) IdentityAPIApiListIdentitiesRequest {
-	r.page = &page
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) PageSize(pageSize int64) IdentityAPIApiListIdentitiesRequest {
-	r.pageSize = &pageSize
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) PageToken(pageToken string) IdentityAPIApiListIdentitiesRequest {
-	r.pageToken = &pageToken
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) Consistency(consistency string) IdentityAPIApiListIdentitiesRequest {
-	r.consistency = &consistency
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) Ids(ids []string) IdentityAPIApiListIdentitiesRequest {
-	r.ids = &ids
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) CredentialsIdentifier(credentialsIdentifier string) IdentityAPIApiListIdentitiesRequest {
-	r.credentialsIdentifier = &credentialsIdentifier
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) PreviewCredentialsIdentifierSimilar(previewCredentialsIdentifierSimilar string) IdentityAPIApiListIdentitiesRequest {
-	r.previewCredentialsIdentifierSimilar = &previewCredentialsIdentifierSimilar
-	return r
-}
-func (r IdentityAPIApiListIdentitiesRequest) IncludeCredential(includeCredential []string) IdentityAPIApiListIdentitiesRequest {
-	r.includeCredential = &includeCredential
+func (r IdentityAPIApiImpersonateIdentityRequest) ImpersonateIdentityBody(impersonateIdentityBody ImpersonateIdentityBody) IdentityAPIApiImpersonateIdentityRequest {
+	r.impersonateIdentityBody = &impersonateIdentityBody
 	return r
 }
 
-func (r IdentityAPIApiListIdentitiesRequest) Execute() ([]Identity, *http.Response, error) {
-	return r.ApiService.ListIdentitiesExecute(r)
+func (r IdentityAPIApiImpersonateIdentityRequest) Execute() (*ImpersonateIdentityResponse, *http.Response, error) {
+	return r.ApiService.ImpersonateIdentityExecute(r)
 }
 
 /*
- * ListIdentities List Identities
- * Lists all [identities](https://www.ory.sh/docs/kratos/concepts/identity-user-model) in the system.
- * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- * @return IdentityAPIApiListIdentitiesRequest
- */
-func (a *IdentityAPIService) ListIdentities(ctx context.Context) IdentityAPIApiListIdentitiesRequest {
-	return IdentityAPIApiListIdentitiesRequest{
+  - ImpersonateIdentity Impersonate an Identity
+  - Issues a session token for the identity to support staff. The session expires after
+
+`session.impersonation.lifespan`, can not be extended, and can not be used to update
+the identity's settings. The impersonator is returned as `impersonation` by the session
+endpoints, including `/sessions/whoami`, and is written to the audit log.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return IdentityAPIApiImpersonateIdentityRequest
+*/
+func (a *IdentityAPIService) ImpersonateIdentity(ctx context.Context) IdentityAPIApiImpersonateIdentityRequest {
+	return IdentityAPIApiImpersonateIdentityRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -2145,74 +2176,31 @@ func (a *IdentityAPIService) ListIdentities(ctx context.Context) IdentityAPIApiL
 
 /*
  * Execute executes the request
- * @return []Identity
+ * @return ImpersonateIdentityResponse
  */
-func (a *IdentityAPIService) ListIdentitiesExecute(r IdentityAPIApiListIdentitiesRequest) ([]Identity, *http.Response, error) {
+func (a *IdentityAPIService) ImpersonateIdentityExecute(r IdentityAPIApiImpersonateIdentityRequest) (*ImpersonateIdentityResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  []Identity
+		localVarReturnValue  *ImpersonateIdentityResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentities")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ImpersonateIdentity")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/identities"
+	localVarPath := localBasePath + "/admin/identity-impersonations"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.perPage != nil {
-		localVarQueryParams.Add("per_page", parameterToString(*r.perPage, ""))
-	}
-	if r.page != nil {
-		localVarQueryParams.Add("page", parameterToString(*r.page, ""))
-	}
-	if r.pageSize != nil {
-		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
-	}
-	if r.pageToken != nil {
-		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
-	}
-	if r.consistency != nil {
-		localVarQueryParams.Add("consistency", parameterToString(*r.consistency, ""))
-	}
-	if r.ids != nil {
-		t := *r.ids
-		if reflect.TypeOf(t).Kind() == reflect.Slice {
-			s := reflect.ValueOf(t)
-			for i := 0; i < s.Len(); i++ {
-				localVarQueryParams.Add("ids", parameterToString(s.Index(i), "multi"))
-			}
-		} else {
-			localVarQueryParams.Add("ids", parameterToString(t, "multi"))
-		}
-	}
-	if r.credentialsIdentifier != nil {
-		localVarQueryParams.Add("credentials_identifier", parameterToString(*r.credentialsIdentifier, ""))
-	}
-	if r.previewCredentialsIdentifierSimilar != nil {
-		localVarQueryParams.Add("preview_credentials_identifier_similar", parameterToString(*r.previewCredentialsIdentifierSimilar, ""))
-	}
-	if r.includeCredential != nil {
-		t := *r.includeCredential
-		if reflect.TypeOf(t).Kind() == reflect.Slice {
-			s := reflect.ValueOf(t)
-			for i := 0; i < s.Len(); i++ {
-				localVarQueryParams.Add("include_credential", parameterToString(s.Index(i), "multi"))
-			}
-		} else {
-			localVarQueryParams.Add("include_credential", parameterToString(t, "multi"))
-		}
-	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/json"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -2228,6 +2216,8 @@ func (a *IdentityAPIService) ListIdentitiesExecute(r IdentityAPIApiListIdentitie
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.impersonateIdentityBody
 	if r.ctx != nil {
 		// API Key Authentication
 		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
@@ -2264,6 +2254,26 @@ func (a *IdentityAPIService) ListIdentitiesExecute(r IdentityAPIApiListIdentitie
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		var v ErrorGeneric
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
@@ -2286,44 +2296,45 @@ func (a *IdentityAPIService) ListIdentitiesExecute(r IdentityAPIApiListIdentitie
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type IdentityAPIApiListIdentitySchemasRequest struct {
+type IdentityAPIApiImportIdentitiesRequest struct {
 	ctx        context.Context
 	ApiService IdentityAPI
-	perPage    *int64
-	page       *int64
-	pageSize   *int64
-	pageToken  *string
+	batchSize  *int64
+	offset     *int64
+	body       *string
 }
 
-func (r IdentityAPIApiListIdentitySchemasRequest) PerPage(perPage int64) IdentityAPIApiListIdentitySchemasRequest {
-	r.perPage = &perPage
-	return r
-}
-func (r IdentityAPIApiListIdentitySchemasRequest) Page(page int64) IdentityAPIApiListIdentitySchemasRequest {
-	r.page = &page
+func (r IdentityAPIApiImportIdentitiesRequest) BatchSize(batchSize int64) IdentityAPIApiImportIdentitiesRequest {
+	r.batchSize = &batchSize
 	return r
 }
-func (r IdentityAPIApiListIdentitySchemasRequest) PageSize(pageSize int64) IdentityAPIApiListIdentitySchemasRequest {
-	r.pageSize = &pageSize
+func (r IdentityAPIApiImportIdentitiesRequest) Offset(offset int64) IdentityAPIApiImportIdentitiesRequest {
+	r.offset = &offset
 	return r
 }
-func (r IdentityAPIApiListIdentitySchemasRequest) PageToken(pageToken string) IdentityAPIApiListIdentitySchemasRequest {
-	r.pageToken = &pageToken
+func (r IdentityAPIApiImportIdentitiesRequest) Body(body string) IdentityAPIApiImportIdentitiesRequest {
+	r.body = &body
 	return r
 }
 
-func (r IdentityAPIApiListIdentitySchemasRequest) Execute() ([]IdentitySchemaContainer, *http.Response, error) {
-	return r.ApiService.ListIdentitySchemasExecute(r)
+func (r IdentityAPIApiImportIdentitiesRequest) Execute() (*ImportIdentitiesResponse, *http.Response, error) {
+	return r.ApiService.ImportIdentitiesExecute(r)
 }
 
 /*
- * ListIdentitySchemas Get all Identity Schemas
- * Returns a list of all identity schemas currently in use.
- * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- * @return IdentityAPIApiListIdentitySchemasRequest
- */
-func (a *IdentityAPIService) ListIdentitySchemas(ctx context.Context) IdentityAPIApiListIdentitySchemasRequest {
-	return IdentityAPIApiListIdentitySchemasRequest{
+  - ImportIdentities Import identities from newline-delimited JSON
+  - Streams identities from the request body, one createIdentityBody per
+
+line, and creates them in batches. Credentials can be imported the same
+way as with the create identity endpoint, including hashed passwords.
+
+Lines which fail to parse, validate, or insert are reported with their
+line number and do not abort the import.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return IdentityAPIApiImportIdentitiesRequest
+*/
+func (a *IdentityAPIService) ImportIdentities(ctx context.Context) IdentityAPIApiImportIdentitiesRequest {
+	return IdentityAPIApiImportIdentitiesRequest{
 		ApiService: a,
 		ctx:        ctx,
 	}
@@ -2331,43 +2342,37 @@ func (a *IdentityAPIService) ListIdentitySchemas(ctx context.Context) IdentityAP
 
 /*
  * Execute executes the request
- * @return []IdentitySchemaContainer
+ * @return ImportIdentitiesResponse
  */
-func (a *IdentityAPIService) ListIdentitySchemasExecute(r IdentityAPIApiListIdentitySchemasRequest) ([]IdentitySchemaContainer, *http.Response, error) {
+func (a *IdentityAPIService) ImportIdentitiesExecute(r IdentityAPIApiImportIdentitiesRequest) (*ImportIdentitiesResponse, *http.Response, error) {
 	var (
-		localVarHTTPMethod   = http.MethodGet
+		localVarHTTPMethod   = http.MethodPost
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  []IdentitySchemaContainer
+		localVarReturnValue  *ImportIdentitiesResponse
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentitySchemas")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ImportIdentities")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/schemas"
+	localVarPath := localBasePath + "/admin/identities/import"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.perPage != nil {
-		localVarQueryParams.Add("per_page", parameterToString(*r.perPage, ""))
-	}
-	if r.page != nil {
-		localVarQueryParams.Add("page", parameterToString(*r.page, ""))
+	if r.batchSize != nil {
+		localVarQueryParams.Add("batch_size", parameterToString(*r.batchSize, ""))
 	}
-	if r.pageSize != nil {
-		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
-	}
-	if r.pageToken != nil {
-		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
+	if r.offset != nil {
+		localVarQueryParams.Add("offset", parameterToString(*r.offset, ""))
 	}
 	// to determine the Content-Type header
-	localVarHTTPContentTypes := []string{}
+	localVarHTTPContentTypes := []string{"application/x-ndjson"}
 
 	// set Content-Type header
 	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
@@ -2383,6 +2388,22 @@ func (a *IdentityAPIService) ListIdentitySchemasExecute(r IdentityAPIApiListIden
 	if localVarHTTPHeaderAccept != "" {
 		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
 	}
+	// body params
+	localVarPostBody = r.body
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["oryAccessToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
 	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
 	if err != nil {
 		return localVarReturnValue, nil, err
@@ -2405,6 +2426,16 @@ func (a *IdentityAPIService) ListIdentitySchemasExecute(r IdentityAPIApiListIden
 			body:  localVarBody,
 			error: localVarHTTPResponse.Status,
 		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
 		var v ErrorGeneric
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
@@ -2427,22 +2458,374 @@ func (a *IdentityAPIService) ListIdentitySchemasExecute(r IdentityAPIApiListIden
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
 
-type IdentityAPIApiListIdentitySessionsRequest struct {
-	ctx        context.Context
-	ApiService IdentityAPI
-	id         string
-	perPage    *int64
-	page       *int64
-	pageSize   *int64
-	pageToken  *string
-	active     *bool
+type IdentityAPIApiListIdentitiesRequest struct {
+	ctx                                 context.Context
+	ApiService                          IdentityAPI
+	perPage                             *int64
+	page                                *int64
+	pageSize                            *int64
+	pageToken                           *string
+	consistency                         *string
+	ids                                 *[]string
+	credentialsIdentifier               *string
+	previewCredentialsIdentifierSimilar *string
+	includeCredential                   *[]string
 }
 
-func (r IdentityAPIApiListIdentitySessionsRequest) PerPage(perPage int64) IdentityAPIApiListIdentitySessionsRequest {
+func (r IdentityAPIApiListIdentitiesRequest) PerPage(perPage int64) IdentityAPIApiListIdentitiesRequest {
 	r.perPage = &perPage
 	return r
 }
-func (r IdentityAPIApiListIdentitySessionsRequest) Page(page int64) IdentityAPIApiListIdentitySessionsRequest {
+func (r IdentityAPIApiListIdentitiesRequest) Page(page int64) IdentityAPIApiListIdentitiesRequest {
+	r.page = &page
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) PageSize(pageSize int64) IdentityAPIApiListIdentitiesRequest {
+	r.pageSize = &pageSize
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) PageToken(pageToken string) IdentityAPIApiListIdentitiesRequest {
+	r.pageToken = &pageToken
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) Consistency(consistency string) IdentityAPIApiListIdentitiesRequest {
+	r.consistency = &consistency
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) Ids(ids []string) IdentityAPIApiListIdentitiesRequest {
+	r.ids = &ids
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) CredentialsIdentifier(credentialsIdentifier string) IdentityAPIApiListIdentitiesRequest {
+	r.credentialsIdentifier = &credentialsIdentifier
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) PreviewCredentialsIdentifierSimilar(previewCredentialsIdentifierSimilar string) IdentityAPIApiListIdentitiesRequest {
+	r.previewCredentialsIdentifierSimilar = &previewCredentialsIdentifierSimilar
+	return r
+}
+func (r IdentityAPIApiListIdentitiesRequest) IncludeCredential(includeCredential []string) IdentityAPIApiListIdentitiesRequest {
+	r.includeCredential = &includeCredential
+	return r
+}
+
+func (r IdentityAPIApiListIdentitiesRequest) Execute() ([]Identity, *http.Response, error) {
+	return r.ApiService.ListIdentitiesExecute(r)
+}
+
+/*
+ * ListIdentities List Identities
+ * Lists all [identities](https://www.ory.sh/docs/kratos/concepts/identity-user-model) in the system.
+ * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ * @return IdentityAPIApiListIdentitiesRequest
+ */
+func (a *IdentityAPIService) ListIdentities(ctx context.Context) IdentityAPIApiListIdentitiesRequest {
+	return IdentityAPIApiListIdentitiesRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return []Identity
+ */
+func (a *IdentityAPIService) ListIdentitiesExecute(r IdentityAPIApiListIdentitiesRequest) ([]Identity, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []Identity
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentities")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/identities"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.perPage != nil {
+		localVarQueryParams.Add("per_page", parameterToString(*r.perPage, ""))
+	}
+	if r.page != nil {
+		localVarQueryParams.Add("page", parameterToString(*r.page, ""))
+	}
+	if r.pageSize != nil {
+		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
+	}
+	if r.pageToken != nil {
+		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
+	}
+	if r.consistency != nil {
+		localVarQueryParams.Add("consistency", parameterToString(*r.consistency, ""))
+	}
+	if r.ids != nil {
+		t := *r.ids
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				localVarQueryParams.Add("ids", parameterToString(s.Index(i), "multi"))
+			}
+		} else {
+			localVarQueryParams.Add("ids", parameterToString(t, "multi"))
+		}
+	}
+	if r.credentialsIdentifier != nil {
+		localVarQueryParams.Add("credentials_identifier", parameterToString(*r.credentialsIdentifier, ""))
+	}
+	if r.previewCredentialsIdentifierSimilar != nil {
+		localVarQueryParams.Add("preview_credentials_identifier_similar", parameterToString(*r.previewCredentialsIdentifierSimilar, ""))
+	}
+	if r.includeCredential != nil {
+		t := *r.includeCredential
+		if reflect.TypeOf(t).Kind() == reflect.Slice {
+			s := reflect.ValueOf(t)
+			for i := 0; i < s.Len(); i++ {
+				localVarQueryParams.Add("include_credential", parameterToString(s.Index(i), "multi"))
+			}
+		} else {
+			localVarQueryParams.Add("include_credential", parameterToString(t, "multi"))
+		}
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["oryAccessToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(io.LimitReader(localVarHTTPResponse.Body, 1024*1024))
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type IdentityAPIApiListIdentitySchemasRequest struct {
+	ctx        context.Context
+	ApiService IdentityAPI
+	perPage    *int64
+	page       *int64
+	pageSize   *int64
+	pageToken  *string
+}
+
+func (r IdentityAPIApiListIdentitySchemasRequest) PerPage(perPage int64) IdentityAPIApiListIdentitySchemasRequest {
+	r.perPage = &perPage
+	return r
+}
+func (r IdentityAPIApiListIdentitySchemasRequest) Page(page int64) IdentityAPIApiListIdentitySchemasRequest {
+	r.page = &page
+	return r
+}
+func (r IdentityAPIApiListIdentitySchemasRequest) PageSize(pageSize int64) IdentityAPIApiListIdentitySchemasRequest {
+	r.pageSize = &pageSize
+	return r
+}
+func (r IdentityAPIApiListIdentitySchemasRequest) PageToken(pageToken string) IdentityAPIApiListIdentitySchemasRequest {
+	r.pageToken = &pageToken
+	return r
+}
+
+func (r IdentityAPIApiListIdentitySchemasRequest) Execute() ([]IdentitySchemaContainer, *http.Response, error) {
+	return r.ApiService.ListIdentitySchemasExecute(r)
+}
+
+/*
+ * ListIdentitySchemas Get all Identity Schemas
+ * Returns a list of all identity schemas currently in use.
+ * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ * @return IdentityAPIApiListIdentitySchemasRequest
+ */
+func (a *IdentityAPIService) ListIdentitySchemas(ctx context.Context) IdentityAPIApiListIdentitySchemasRequest {
+	return IdentityAPIApiListIdentitySchemasRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return []IdentitySchemaContainer
+ */
+func (a *IdentityAPIService) ListIdentitySchemasExecute(r IdentityAPIApiListIdentitySchemasRequest) ([]IdentitySchemaContainer, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []IdentitySchemaContainer
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentitySchemas")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/schemas"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.perPage != nil {
+		localVarQueryParams.Add("per_page", parameterToString(*r.perPage, ""))
+	}
+	if r.page != nil {
+		localVarQueryParams.Add("page", parameterToString(*r.page, ""))
+	}
+	if r.pageSize != nil {
+		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
+	}
+	if r.pageToken != nil {
+		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(io.LimitReader(localVarHTTPResponse.Body, 1024*1024))
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type IdentityAPIApiListIdentitySessionsRequest struct {
+	ctx        context.Context
+	ApiService IdentityAPI
+	id         string
+	perPage    *int64
+	page       *int64
+	pageSize   *int64
+	pageToken  *string
+	active     *bool
+}
+
+func (r IdentityAPIApiListIdentitySessionsRequest) PerPage(perPage int64) IdentityAPIApiListIdentitySessionsRequest {
+	r.perPage = &perPage
+	return r
+}
+func (r IdentityAPIApiListIdentitySessionsRequest) Page(page int64) IdentityAPIApiListIdentitySessionsRequest {
 	r.page = &page
 	return r
 }
@@ -2450,74 +2833,251 @@ func (r IdentityAPIApiListIdentitySessionsRequest) PageSize(pageSize int64) Iden
 	r.pageSize = &pageSize
 	return r
 }
-func (r IdentityAPIApiListIdentitySessionsRequest) PageToken(pageToken string) IdentityAPIApiListIdentitySessionsRequest {
+func (r IdentityAPIApiListIdentitySessionsRequest) PageToken(pageToken string) IdentityAPIApiListIdentitySessionsRequest {
+	r.pageToken = &pageToken
+	return r
+}
+func (r IdentityAPIApiListIdentitySessionsRequest) Active(active bool) IdentityAPIApiListIdentitySessionsRequest {
+	r.active = &active
+	return r
+}
+
+func (r IdentityAPIApiListIdentitySessionsRequest) Execute() ([]Session, *http.Response, error) {
+	return r.ApiService.ListIdentitySessionsExecute(r)
+}
+
+/*
+ * ListIdentitySessions List an Identity's Sessions
+ * This endpoint returns all sessions that belong to the given Identity.
+ * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+ * @param id ID is the identity's ID.
+ * @return IdentityAPIApiListIdentitySessionsRequest
+ */
+func (a *IdentityAPIService) ListIdentitySessions(ctx context.Context, id string) IdentityAPIApiListIdentitySessionsRequest {
+	return IdentityAPIApiListIdentitySessionsRequest{
+		ApiService: a,
+		ctx:        ctx,
+		id:         id,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return []Session
+ */
+func (a *IdentityAPIService) ListIdentitySessionsExecute(r IdentityAPIApiListIdentitySessionsRequest) ([]Session, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodGet
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  []Session
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentitySessions")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/identities/{id}/sessions"
+	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+
+	if r.perPage != nil {
+		localVarQueryParams.Add("per_page", parameterToString(*r.perPage, ""))
+	}
+	if r.page != nil {
+		localVarQueryParams.Add("page", parameterToString(*r.page, ""))
+	}
+	if r.pageSize != nil {
+		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
+	}
+	if r.pageToken != nil {
+		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
+	}
+	if r.active != nil {
+		localVarQueryParams.Add("active", parameterToString(*r.active, ""))
+	}
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["oryAccessToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(io.LimitReader(localVarHTTPResponse.Body, 1024*1024))
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		if localVarHTTPResponse.StatusCode == 404 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
+
+type IdentityAPIApiListIdentityWebHookDeliveriesRequest struct {
+	ctx        context.Context
+	ApiService IdentityAPI
+	pageSize   *int64
+	pageToken  *string
+	identityId *string
+	status     *string
+}
+
+func (r IdentityAPIApiListIdentityWebHookDeliveriesRequest) PageSize(pageSize int64) IdentityAPIApiListIdentityWebHookDeliveriesRequest {
+	r.pageSize = &pageSize
+	return r
+}
+func (r IdentityAPIApiListIdentityWebHookDeliveriesRequest) PageToken(pageToken string) IdentityAPIApiListIdentityWebHookDeliveriesRequest {
 	r.pageToken = &pageToken
 	return r
 }
-func (r IdentityAPIApiListIdentitySessionsRequest) Active(active bool) IdentityAPIApiListIdentitySessionsRequest {
-	r.active = &active
+func (r IdentityAPIApiListIdentityWebHookDeliveriesRequest) IdentityId(identityId string) IdentityAPIApiListIdentityWebHookDeliveriesRequest {
+	r.identityId = &identityId
+	return r
+}
+func (r IdentityAPIApiListIdentityWebHookDeliveriesRequest) Status(status string) IdentityAPIApiListIdentityWebHookDeliveriesRequest {
+	r.status = &status
 	return r
 }
 
-func (r IdentityAPIApiListIdentitySessionsRequest) Execute() ([]Session, *http.Response, error) {
-	return r.ApiService.ListIdentitySessionsExecute(r)
+func (r IdentityAPIApiListIdentityWebHookDeliveriesRequest) Execute() ([]IdentityWebHookDelivery, *http.Response, error) {
+	return r.ApiService.ListIdentityWebHookDeliveriesExecute(r)
 }
 
 /*
- * ListIdentitySessions List an Identity's Sessions
- * This endpoint returns all sessions that belong to the given Identity.
- * @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
- * @param id ID is the identity's ID.
- * @return IdentityAPIApiListIdentitySessionsRequest
- */
-func (a *IdentityAPIService) ListIdentitySessions(ctx context.Context, id string) IdentityAPIApiListIdentitySessionsRequest {
-	return IdentityAPIApiListIdentitySessionsRequest{
+  - ListIdentityWebHookDeliveries List Identity Web Hook Deliveries
+  - Lists the deliveries of the identity lifecycle web hooks configured in
+
+`identity.webhooks`, newest first.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return IdentityAPIApiListIdentityWebHookDeliveriesRequest
+*/
+func (a *IdentityAPIService) ListIdentityWebHookDeliveries(ctx context.Context) IdentityAPIApiListIdentityWebHookDeliveriesRequest {
+	return IdentityAPIApiListIdentityWebHookDeliveriesRequest{
 		ApiService: a,
 		ctx:        ctx,
-		id:         id,
 	}
 }
 
 /*
  * Execute executes the request
- * @return []Session
+ * @return []IdentityWebHookDelivery
  */
-func (a *IdentityAPIService) ListIdentitySessionsExecute(r IdentityAPIApiListIdentitySessionsRequest) ([]Session, *http.Response, error) {
+func (a *IdentityAPIService) ListIdentityWebHookDeliveriesExecute(r IdentityAPIApiListIdentityWebHookDeliveriesRequest) ([]IdentityWebHookDelivery, *http.Response, error) {
 	var (
 		localVarHTTPMethod   = http.MethodGet
 		localVarPostBody     interface{}
 		localVarFormFileName string
 		localVarFileName     string
 		localVarFileBytes    []byte
-		localVarReturnValue  []Session
+		localVarReturnValue  []IdentityWebHookDelivery
 	)
 
-	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentitySessions")
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ListIdentityWebHookDeliveries")
 	if err != nil {
 		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
 	}
 
-	localVarPath := localBasePath + "/admin/identities/{id}/sessions"
-	localVarPath = strings.Replace(localVarPath, "{"+"id"+"}", url.PathEscape(parameterToString(r.id, "")), -1)
+	localVarPath := localBasePath + "/admin/identity-webhook-deliveries"
 
 	localVarHeaderParams := make(map[string]string)
 	localVarQueryParams := url.Values{}
 	localVarFormParams := url.Values{}
 
-	if r.perPage != nil {
-		localVarQueryParams.Add("per_page", parameterToString(*r.perPage, ""))
-	}
-	if r.page != nil {
-		localVarQueryParams.Add("page", parameterToString(*r.page, ""))
-	}
 	if r.pageSize != nil {
 		localVarQueryParams.Add("page_size", parameterToString(*r.pageSize, ""))
 	}
 	if r.pageToken != nil {
 		localVarQueryParams.Add("page_token", parameterToString(*r.pageToken, ""))
 	}
-	if r.active != nil {
-		localVarQueryParams.Add("active", parameterToString(*r.active, ""))
+	if r.identityId != nil {
+		localVarQueryParams.Add("identity_id", parameterToString(*r.identityId, ""))
+	}
+	if r.status != nil {
+		localVarQueryParams.Add("status", parameterToString(*r.status, ""))
 	}
 	// to determine the Content-Type header
 	localVarHTTPContentTypes := []string{}
@@ -2582,16 +3142,6 @@ func (a *IdentityAPIService) ListIdentitySessionsExecute(r IdentityAPIApiListIde
 			newErr.model = v
 			return localVarReturnValue, localVarHTTPResponse, newErr
 		}
-		if localVarHTTPResponse.StatusCode == 404 {
-			var v ErrorGeneric
-			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
-			if err != nil {
-				newErr.error = err.Error()
-				return localVarReturnValue, localVarHTTPResponse, newErr
-			}
-			newErr.model = v
-			return localVarReturnValue, localVarHTTPResponse, newErr
-		}
 		var v ErrorGeneric
 		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
 		if err != nil {
@@ -3118,3 +3668,149 @@ func (a *IdentityAPIService) UpdateIdentityExecute(r IdentityAPIApiUpdateIdentit
 
 	return localVarReturnValue, localVarHTTPResponse, nil
 }
+
+type IdentityAPIApiValidatePasswordPolicyRequest struct {
+	ctx                        context.Context
+	ApiService                 IdentityAPI
+	validatePasswordPolicyBody *ValidatePasswordPolicyBody
+}
+
+func (r IdentityAPIApiValidatePasswordPolicyRequest) ValidatePasswordPolicyBody(validatePasswordPolicyBody ValidatePasswordPolicyBody) IdentityAPIApiValidatePasswordPolicyRequest {
+	r.validatePasswordPolicyBody = &validatePasswordPolicyBody
+	return r
+}
+
+func (r IdentityAPIApiValidatePasswordPolicyRequest) Execute() (*PasswordPolicyValidation, *http.Response, error) {
+	return r.ApiService.ValidatePasswordPolicyExecute(r)
+}
+
+/*
+  - ValidatePasswordPolicy Validate a Password Against the Password Policy
+  - Checks a sample password against the password policy of the
+
+`selfservice.methods.password.config` section, including the
+haveibeenpwned check if it is enabled. Nothing is stored.
+  - @param ctx context.Context - for authentication, logging, cancellation, deadlines, tracing, etc. Passed from http.Request or context.Background().
+  - @return IdentityAPIApiValidatePasswordPolicyRequest
+*/
+func (a *IdentityAPIService) ValidatePasswordPolicy(ctx context.Context) IdentityAPIApiValidatePasswordPolicyRequest {
+	return IdentityAPIApiValidatePasswordPolicyRequest{
+		ApiService: a,
+		ctx:        ctx,
+	}
+}
+
+/*
+ * Execute executes the request
+ * @return PasswordPolicyValidation
+ */
+func (a *IdentityAPIService) ValidatePasswordPolicyExecute(r IdentityAPIApiValidatePasswordPolicyRequest) (*PasswordPolicyValidation, *http.Response, error) {
+	var (
+		localVarHTTPMethod   = http.MethodPost
+		localVarPostBody     interface{}
+		localVarFormFileName string
+		localVarFileName     string
+		localVarFileBytes    []byte
+		localVarReturnValue  *PasswordPolicyValidation
+	)
+
+	localBasePath, err := a.client.cfg.ServerURLWithContext(r.ctx, "IdentityAPIService.ValidatePasswordPolicy")
+	if err != nil {
+		return localVarReturnValue, nil, &GenericOpenAPIError{error: err.Error()}
+	}
+
+	localVarPath := localBasePath + "/admin/password-policy/validate"
+
+	localVarHeaderParams := make(map[string]string)
+	localVarQueryParams := url.Values{}
+	localVarFormParams := url.Values{}
+	if r.validatePasswordPolicyBody == nil {
+		return localVarReturnValue, nil, reportError("validatePasswordPolicyBody is required and must be specified")
+	}
+
+	// to determine the Content-Type header
+	localVarHTTPContentTypes := []string{"application/json"}
+
+	// set Content-Type header
+	localVarHTTPContentType := selectHeaderContentType(localVarHTTPContentTypes)
+	if localVarHTTPContentType != "" {
+		localVarHeaderParams["Content-Type"] = localVarHTTPContentType
+	}
+
+	// to determine the Accept header
+	localVarHTTPHeaderAccepts := []string{"application/json"}
+
+	// set Accept header
+	localVarHTTPHeaderAccept := selectHeaderAccept(localVarHTTPHeaderAccepts)
+	if localVarHTTPHeaderAccept != "" {
+		localVarHeaderParams["Accept"] = localVarHTTPHeaderAccept
+	}
+	// body params
+	localVarPostBody = r.validatePasswordPolicyBody
+	if r.ctx != nil {
+		// API Key Authentication
+		if auth, ok := r.ctx.Value(ContextAPIKeys).(map[string]APIKey); ok {
+			if apiKey, ok := auth["oryAccessToken"]; ok {
+				var key string
+				if apiKey.Prefix != "" {
+					key = apiKey.Prefix + " " + apiKey.Key
+				} else {
+					key = apiKey.Key
+				}
+				localVarHeaderParams["Authorization"] = key
+			}
+		}
+	}
+	req, err := a.client.prepareRequest(r.ctx, localVarPath, localVarHTTPMethod, localVarPostBody, localVarHeaderParams, localVarQueryParams, localVarFormParams, localVarFormFileName, localVarFileName, localVarFileBytes)
+	if err != nil {
+		return localVarReturnValue, nil, err
+	}
+
+	localVarHTTPResponse, err := a.client.callAPI(req)
+	if err != nil || localVarHTTPResponse == nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	localVarBody, err := io.ReadAll(io.LimitReader(localVarHTTPResponse.Body, 1024*1024))
+	localVarHTTPResponse.Body.Close()
+	localVarHTTPResponse.Body = io.NopCloser(bytes.NewBuffer(localVarBody))
+	if err != nil {
+		return localVarReturnValue, localVarHTTPResponse, err
+	}
+
+	if localVarHTTPResponse.StatusCode >= 300 {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: localVarHTTPResponse.Status,
+		}
+		if localVarHTTPResponse.StatusCode == 400 {
+			var v ErrorGeneric
+			err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+			if err != nil {
+				newErr.error = err.Error()
+				return localVarReturnValue, localVarHTTPResponse, newErr
+			}
+			newErr.model = v
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		var v ErrorGeneric
+		err = a.client.decode(&v, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+		if err != nil {
+			newErr.error = err.Error()
+			return localVarReturnValue, localVarHTTPResponse, newErr
+		}
+		newErr.model = v
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	err = a.client.decode(&localVarReturnValue, localVarBody, localVarHTTPResponse.Header.Get("Content-Type"))
+	if err != nil {
+		newErr := &GenericOpenAPIError{
+			body:  localVarBody,
+			error: err.Error(),
+		}
+		return localVarReturnValue, localVarHTTPResponse, newErr
+	}
+
+	return localVarReturnValue, localVarHTTPResponse, nil
+}
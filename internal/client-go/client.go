@@ -56,6 +56,8 @@ type APIClient struct {
 	IdentityAPI IdentityAPI
 
 	MetadataAPI MetadataAPI
+
+	UsageAPI UsageAPI
 }
 
 type service struct {
@@ -78,6 +80,7 @@ func NewAPIClient(cfg *Configuration) *APIClient {
 	c.FrontendAPI = (*FrontendAPIService)(&c.common)
 	c.IdentityAPI = (*IdentityAPIService)(&c.common)
 	c.MetadataAPI = (*MetadataAPIService)(&c.common)
+	c.UsageAPI = (*UsageAPIService)(&c.common)
 
 	return c
 }
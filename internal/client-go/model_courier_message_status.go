@@ -25,6 +25,7 @@ const (
 	COURIERMESSAGESTATUS_SENT       CourierMessageStatus = "sent"
 	COURIERMESSAGESTATUS_PROCESSING CourierMessageStatus = "processing"
 	COURIERMESSAGESTATUS_ABANDONED  CourierMessageStatus = "abandoned"
+	COURIERMESSAGESTATUS_SUPPRESSED CourierMessageStatus = "suppressed"
 )
 
 func (v *CourierMessageStatus) UnmarshalJSON(src []byte) error {
@@ -34,7 +35,7 @@ func (v *CourierMessageStatus) UnmarshalJSON(src []byte) error {
 		return err
 	}
 	enumTypeValue := CourierMessageStatus(value)
-	for _, existing := range []CourierMessageStatus{"queued", "sent", "processing", "abandoned"} {
+	for _, existing := range []CourierMessageStatus{"queued", "sent", "processing", "abandoned", "suppressed"} {
 		if existing == enumTypeValue {
 			*v = enumTypeValue
 			return nil
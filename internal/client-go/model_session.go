@@ -30,8 +30,9 @@ type Session struct {
 	// The Session Expiry  When this session expires at.
 	ExpiresAt *time.Time `json:"expires_at,omitempty"`
 	// Session ID
-	Id       string    `json:"id"`
-	Identity *Identity `json:"identity,omitempty"`
+	Id            string                `json:"id"`
+	Identity      *Identity             `json:"identity,omitempty"`
+	Impersonation *SessionImpersonation `json:"impersonation,omitempty"`
 	// The Session Issuance Timestamp  When this session was issued at. Usually equal or close to `authenticated_at`.
 	IssuedAt *time.Time `json:"issued_at,omitempty"`
 	// Tokenized is the tokenized (e.g. JWT) version of the session.  It is only set when the `tokenize` query parameter was set to a valid tokenize template during calls to `/session/whoami`.
@@ -304,6 +305,38 @@ func (o *Session) SetIdentity(v Identity) {
 	o.Identity = &v
 }
 
+// GetImpersonation returns the Impersonation field value if set, zero value otherwise.
+func (o *Session) GetImpersonation() SessionImpersonation {
+	if o == nil || o.Impersonation == nil {
+		var ret SessionImpersonation
+		return ret
+	}
+	return *o.Impersonation
+}
+
+// GetImpersonationOk returns a tuple with the Impersonation field value if set, nil otherwise
+// and a boolean to check if the value has been set.
+func (o *Session) GetImpersonationOk() (*SessionImpersonation, bool) {
+	if o == nil || o.Impersonation == nil {
+		return nil, false
+	}
+	return o.Impersonation, true
+}
+
+// HasImpersonation returns a boolean if a field has been set.
+func (o *Session) HasImpersonation() bool {
+	if o != nil && o.Impersonation != nil {
+		return true
+	}
+
+	return false
+}
+
+// SetImpersonation gets a reference to the given SessionImpersonation and assigns it to the Impersonation field.
+func (o *Session) SetImpersonation(v SessionImpersonation) {
+	o.Impersonation = &v
+}
+
 // GetIssuedAt returns the IssuedAt field value if set, zero value otherwise.
 func (o *Session) GetIssuedAt() time.Time {
 	if o == nil || o.IssuedAt == nil {
@@ -394,6 +427,9 @@ func (o Session) MarshalJSON() ([]byte, error) {
 	if o.Identity != nil {
 		toSerialize["identity"] = o.Identity
 	}
+	if o.Impersonation != nil {
+		toSerialize["impersonation"] = o.Impersonation
+	}
 	if o.IssuedAt != nil {
 		toSerialize["issued_at"] = o.IssuedAt
 	}
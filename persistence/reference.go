@@ -29,7 +29,9 @@ import (
 	"github.com/ory/kratos/selfservice/flow/verification"
 	"github.com/ory/kratos/selfservice/strategy/code"
 	"github.com/ory/kratos/selfservice/strategy/link"
+	"github.com/ory/kratos/selfservice/strategy/password"
 	"github.com/ory/kratos/session"
+	"github.com/ory/kratos/usage"
 )
 
 type Provider interface {
@@ -55,6 +57,9 @@ type Persister interface {
 	code.VerificationCodePersister
 	code.RegistrationCodePersister
 	code.LoginCodePersister
+	password.LoginAttemptsPersister
+	identity.WebHookDeliveryPersister
+	usage.Persister
 
 	CleanupDatabase(context.Context, time.Duration, time.Duration, int) error
 	Close(context.Context) error
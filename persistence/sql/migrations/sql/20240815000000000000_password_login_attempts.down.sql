@@ -0,0 +1 @@
+DROP TABLE selfservice_password_login_attempts;
@@ -0,0 +1,22 @@
+CREATE TABLE selfservice_password_login_attempts
+(
+    id UUID NOT NULL PRIMARY KEY,
+    nid UUID NOT NULL,
+    identifier VARCHAR(255) NOT NULL,
+    failed_attempts INT NOT NULL DEFAULT 0,
+    locked_until timestamp NULL DEFAULT NULL,
+    created_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT selfservice_password_login_attempts_networks_id_fk
+        FOREIGN KEY (nid)
+        REFERENCES networks (id)
+        ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+-- Relevant query:
+--   SELECT * FROM selfservice_password_login_attempts WHERE nid = ? AND identifier = ?
+CREATE UNIQUE INDEX selfservice_password_login_attempts_nid_identifier_uq_idx ON selfservice_password_login_attempts (nid, identifier);
+
+-- Relevant query:
+--   DELETE FROM selfservice_password_login_attempts WHERE updated_at < ? AND ... AND nid = ?
+CREATE INDEX selfservice_password_login_attempts_nid_updated_at_idx ON selfservice_password_login_attempts (nid, updated_at);
@@ -0,0 +1 @@
+DROP TABLE identity_webhook_deliveries;
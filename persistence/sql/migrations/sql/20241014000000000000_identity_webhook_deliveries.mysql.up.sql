@@ -0,0 +1,31 @@
+CREATE TABLE identity_webhook_deliveries
+(
+    id CHAR(36) NOT NULL PRIMARY KEY,
+    nid CHAR(36) NOT NULL,
+    webhook_id VARCHAR(255) NOT NULL,
+    identity_id CHAR(36) NOT NULL,
+    event VARCHAR(64) NOT NULL,
+    payload MEDIUMTEXT NOT NULL,
+    status VARCHAR(32) NOT NULL,
+    attempts INT NOT NULL DEFAULT 0,
+    next_attempt_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    last_error TEXT NULL,
+    created_at timestamp(6) NOT NULL DEFAULT CURRENT_TIMESTAMP(6),
+    updated_at timestamp NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    CONSTRAINT identity_webhook_deliveries_networks_id_fk
+        FOREIGN KEY (nid)
+        REFERENCES networks (id)
+        ON UPDATE RESTRICT ON DELETE CASCADE
+);
+
+-- Relevant query:
+--   SELECT * FROM identity_webhook_deliveries d WHERE nid = ? AND status = ? AND next_attempt_at <= ? AND NOT EXISTS (...) ORDER BY created_at ASC
+CREATE INDEX identity_webhook_deliveries_nid_status_next_attempt_at_idx ON identity_webhook_deliveries (nid, status, next_attempt_at);
+
+-- Relevant query:
+--   SELECT 1 FROM identity_webhook_deliveries e WHERE e.nid = ? AND e.identity_id = ? AND e.webhook_id = ? AND e.status = ? AND e.created_at < ?
+CREATE INDEX identity_webhook_deliveries_nid_identity_id_webhook_id_idx ON identity_webhook_deliveries (nid, identity_id, webhook_id, status, created_at);
+
+-- Relevant query:
+--   SELECT * FROM identity_webhook_deliveries WHERE nid = ? ORDER BY created_at DESC, id DESC
+CREATE INDEX identity_webhook_deliveries_nid_created_at_id_idx ON identity_webhook_deliveries (nid, created_at DESC, id);
@@ -238,6 +238,26 @@ func (p *Persister) CleanupDatabase(ctx context.Context, wait time.Duration, old
 	}
 	time.Sleep(wait)
 
+	p.r.Logger().Println("Cleaning up stale password login attempts")
+	if err := p.DeleteStaleLoginAttempts(ctx, currentTime, batchSize); err != nil {
+		return err
+	}
+	time.Sleep(wait)
+
+	p.r.Logger().Println("Cleaning up delivered and abandoned identity web hook deliveries")
+	if err := p.DeleteExpiredWebHookDeliveries(ctx, currentTime, batchSize); err != nil {
+		return err
+	}
+	time.Sleep(wait)
+
+	if ttl := p.r.Config().CourierMessageTTL(ctx); ttl > 0 {
+		p.r.Logger().Println("Cleaning up sent and abandoned courier messages")
+		if err := p.DeleteExpiredMessages(ctx, time.Now().Add(-ttl), batchSize); err != nil {
+			return err
+		}
+		time.Sleep(wait)
+	}
+
 	p.r.Logger().Println("Successfully cleaned up the latest batch of the SQL database! " +
 		"This should be re-run periodically, to be sure that all expired data is purged.")
 	return nil
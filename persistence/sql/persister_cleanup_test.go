@@ -173,3 +173,39 @@ func TestPersister_SessionTokenExchange_Cleanup(t *testing.T) {
 		assert.Error(t, p.DeleteExpiredExchangers(ctx, currentTime, reg.Config().DatabaseCleanupBatchSize(ctx)))
 	})
 }
+
+func TestPersister_Courier_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	_, reg := internal.NewFastRegistryWithMocks(t)
+	p := reg.Persister()
+	currentTime := time.Now()
+	ctx := context.Background()
+
+	t.Run("case=should not throw error on cleanup courier messages", func(t *testing.T) {
+		assert.Nil(t, p.DeleteExpiredMessages(ctx, currentTime, reg.Config().DatabaseCleanupBatchSize(ctx)))
+	})
+
+	t.Run("case=should throw error on cleanup courier messages if DB is closed", func(t *testing.T) {
+		p.GetConnection(ctx).Close()
+		assert.Error(t, p.DeleteExpiredMessages(ctx, currentTime, reg.Config().DatabaseCleanupBatchSize(ctx)))
+	})
+}
+
+func TestPersister_IdentityWebHookDelivery_Cleanup(t *testing.T) {
+	t.Parallel()
+
+	_, reg := internal.NewFastRegistryWithMocks(t)
+	p := reg.Persister()
+	currentTime := time.Now()
+	ctx := context.Background()
+
+	t.Run("case=should not throw error on cleanup identity web hook deliveries", func(t *testing.T) {
+		assert.Nil(t, p.DeleteExpiredWebHookDeliveries(ctx, currentTime, reg.Config().DatabaseCleanupBatchSize(ctx)))
+	})
+
+	t.Run("case=should throw error on cleanup identity web hook deliveries if DB is closed", func(t *testing.T) {
+		p.GetConnection(ctx).Close()
+		assert.Error(t, p.DeleteExpiredWebHookDeliveries(ctx, currentTime, reg.Config().DatabaseCleanupBatchSize(ctx)))
+	})
+}
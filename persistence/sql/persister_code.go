@@ -70,10 +70,10 @@ func useOneTimeCode[P any, U interface {
 		}
 
 		// This check prevents parallel brute force attacks by checking the submit count inside this database
-		// transaction. If the flow has been submitted more than 5 times, the transaction is aborted (regardless of
+		// transaction. If the flow has been submitted more often than allowed, the transaction is aborted (regardless of
 		// whether the code was correct or not) and we thus give no indication whether the supplied code was correct or
 		// not. For more explanation see [this comment](https://github.com/ory/kratos/pull/2645#discussion_r984732899).
-		if submitCount > 5 {
+		if submitCount > p.r.Config().SelfServiceCodeMethodMaxSubmissions(ctx) {
 			return errors.WithStack(code.ErrCodeSubmittedTooOften)
 		}
 
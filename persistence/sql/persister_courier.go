@@ -7,6 +7,8 @@ import (
 	"context"
 	"database/sql"
 	"encoding/json"
+	"fmt"
+	"time"
 
 	"github.com/gobuffalo/pop/v6"
 	"github.com/gofrs/uuid"
@@ -221,3 +223,21 @@ func (p *Persister) RecordDispatch(ctx context.Context, msgID uuid.UUID, status
 
 	return nil
 }
+
+func (p *Persister) DeleteExpiredMessages(ctx context.Context, before time.Time, limit int) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteExpiredMessages")
+	defer otelx.End(span, &err)
+
+	//#nosec G201 -- TableName is static
+	return sqlcon.HandleError(p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"DELETE FROM %s WHERE id in (SELECT id FROM (SELECT id FROM %s c WHERE created_at < ? AND status IN (?, ?) AND nid = ? ORDER BY created_at ASC LIMIT %d ) AS s )",
+		new(courier.Message).TableName(ctx),
+		new(courier.Message).TableName(ctx),
+		limit,
+	),
+		before,
+		courier.MessageStatusSent,
+		courier.MessageStatusAbandoned,
+		p.NetworkID(ctx),
+	).Exec())
+}
@@ -0,0 +1,142 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/gobuffalo/pop/v6"
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/pagination/keysetpagination"
+	"github.com/ory/x/sqlcon"
+
+	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/persistence/sql/update"
+	"github.com/ory/kratos/x"
+)
+
+var _ identity.WebHookDeliveryPersister = new(Persister)
+
+func (p *Persister) CreateWebHookDeliveries(ctx context.Context, deliveries ...*identity.WebHookDelivery) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.CreateWebHookDeliveries")
+	defer otelx.End(span, &err)
+
+	nid := p.NetworkID(ctx)
+	return p.Transaction(ctx, func(ctx context.Context, tx *pop.Connection) error {
+		for _, d := range deliveries {
+			d.NID = nid
+			if err := tx.Create(d); err != nil {
+				return sqlcon.HandleError(err)
+			}
+		}
+		return nil
+	})
+}
+
+func (p *Persister) NextWebHookDeliveries(ctx context.Context, now time.Time, limit int) (_ []identity.WebHookDelivery, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.NextWebHookDeliveries")
+	defer otelx.End(span, &err)
+
+	table := new(identity.WebHookDelivery).TableName(ctx)
+	var deliveries []identity.WebHookDelivery
+	if err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		//#nosec G201 -- TableName is static
+		`SELECT d.* FROM %[1]s d WHERE d.nid = ? AND d.status = ? AND d.next_attempt_at <= ? AND NOT EXISTS (
+	SELECT 1 FROM %[1]s e WHERE e.nid = d.nid AND e.identity_id = d.identity_id AND e.webhook_id = d.webhook_id AND e.status = ?
+		AND (e.created_at < d.created_at OR (e.created_at = d.created_at AND e.id < d.id))
+) ORDER BY d.created_at ASC, d.id ASC LIMIT %[2]d`, table, limit),
+		p.NetworkID(ctx),
+		identity.WebHookDeliveryStatusQueued,
+		now,
+		identity.WebHookDeliveryStatusQueued,
+	).All(&deliveries); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	return deliveries, nil
+}
+
+func (p *Persister) ClaimWebHookDelivery(ctx context.Context, id uuid.UUID, now, leaseUntil time.Time) (_ bool, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ClaimWebHookDelivery")
+	defer otelx.End(span, &err)
+
+	count, err := p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		//#nosec G201 -- TableName is static
+		"UPDATE %s SET attempts = attempts + 1, next_attempt_at = ? WHERE id = ? AND nid = ? AND status = ? AND next_attempt_at <= ?",
+		new(identity.WebHookDelivery).TableName(ctx),
+	),
+		leaseUntil,
+		id,
+		p.NetworkID(ctx),
+		identity.WebHookDeliveryStatusQueued,
+		now,
+	).ExecWithCount()
+	if err != nil {
+		return false, sqlcon.HandleError(err)
+	}
+
+	return count > 0, nil
+}
+
+func (p *Persister) UpdateWebHookDelivery(ctx context.Context, d *identity.WebHookDelivery) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.UpdateWebHookDelivery")
+	defer otelx.End(span, &err)
+
+	d.NID = p.NetworkID(ctx)
+	return update.Generic(ctx, p.GetConnection(ctx), p.r.Tracer(ctx).Tracer(), d, "status", "attempts", "next_attempt_at", "last_error")
+}
+
+func (p *Persister) ListWebHookDeliveries(ctx context.Context, params identity.ListWebHookDeliveriesParameters, opts []keysetpagination.Option) (_ []identity.WebHookDelivery, _ *keysetpagination.Paginator, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ListWebHookDeliveries")
+	defer otelx.End(span, &err)
+
+	q := p.GetConnection(ctx).Where("nid = ?", p.NetworkID(ctx))
+	if !params.IdentityID.IsNil() {
+		q = q.Where("identity_id = ?", params.IdentityID)
+	}
+	if params.Status != "" {
+		q = q.Where("status = ?", params.Status)
+	}
+
+	opts = append(opts, keysetpagination.WithDefaultToken(new(identity.WebHookDelivery).DefaultPageToken()))
+	opts = append(opts, keysetpagination.WithDefaultSize(100))
+	opts = append(opts, keysetpagination.WithColumn("created_at", "DESC"))
+	paginator := keysetpagination.GetPaginator(opts...)
+
+	if _, err := uuid.FromString(paginator.Token().Parse("id")["id"]); err != nil {
+		return nil, nil, errors.WithStack(x.PageTokenInvalid)
+	}
+
+	deliveries := make([]identity.WebHookDelivery, paginator.Size())
+	if err := q.Scope(keysetpagination.Paginate[identity.WebHookDelivery](paginator)).
+		All(&deliveries); err != nil {
+		return nil, nil, sqlcon.HandleError(err)
+	}
+
+	deliveries, nextPage := keysetpagination.Result(deliveries, paginator)
+	return deliveries, nextPage, nil
+}
+
+func (p *Persister) DeleteExpiredWebHookDeliveries(ctx context.Context, before time.Time, limit int) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteExpiredWebHookDeliveries")
+	defer otelx.End(span, &err)
+
+	//#nosec G201 -- TableName is static
+	return sqlcon.HandleError(p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"DELETE FROM %s WHERE id in (SELECT id FROM (SELECT id FROM %s d WHERE created_at < ? AND status IN (?, ?) AND nid = ? ORDER BY created_at ASC LIMIT %d ) AS s )",
+		new(identity.WebHookDelivery).TableName(ctx),
+		new(identity.WebHookDelivery).TableName(ctx),
+		limit,
+	),
+		before,
+		identity.WebHookDeliveryStatusDelivered,
+		identity.WebHookDeliveryStatusAbandoned,
+		p.NetworkID(ctx),
+	).Exec())
+}
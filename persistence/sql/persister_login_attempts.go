@@ -0,0 +1,125 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/identity"
+	idpersistence "github.com/ory/kratos/persistence/sql/identity"
+	"github.com/ory/kratos/selfservice/strategy/password"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+)
+
+var _ password.LoginAttemptsPersister = new(Persister)
+
+func (p *Persister) GetLoginAttempts(ctx context.Context, identifier string) (_ *password.LoginAttempts, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.GetLoginAttempts")
+	defer otelx.End(span, &err)
+
+	var a password.LoginAttempts
+	if err := p.GetConnection(ctx).
+		Where("nid = ? AND identifier = ?", p.NetworkID(ctx), idpersistence.NormalizeIdentifier(identity.CredentialsTypePassword, identifier)).
+		First(&a); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	return &a, nil
+}
+
+func (p *Persister) RecordFailedLoginAttempt(ctx context.Context, identifier string, now time.Time, maxAttempts int, lockout time.Duration) (_ *password.LoginAttempts, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.RecordFailedLoginAttempt")
+	defer otelx.End(span, &err)
+
+	nid := p.NetworkID(ctx)
+	identifier = idpersistence.NormalizeIdentifier(identity.CredentialsTypePassword, identifier)
+	conn := p.GetConnection(ctx)
+	table := conn.Dialect.Quote(new(password.LoginAttempts).TableName(ctx))
+
+	// Every step below is a single statement which the database applies
+	// atomically, so parallel failed logins can not lose increments.
+
+	// A lockout which elapsed starts a fresh count.
+	//#nosec G201 -- TableName is static
+	if err := conn.RawQuery(fmt.Sprintf(
+		"UPDATE %s SET failed_attempts = 0, locked_until = NULL, updated_at = ? WHERE nid = ? AND identifier = ? AND locked_until IS NOT NULL AND locked_until <= ?", table),
+		now, nid, identifier, now,
+	).Exec(); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	increment := func() (int, error) {
+		//#nosec G201 -- TableName is static
+		return conn.RawQuery(fmt.Sprintf(
+			"UPDATE %s SET failed_attempts = failed_attempts + 1, updated_at = ? WHERE nid = ? AND identifier = ?", table),
+			now, nid, identifier,
+		).ExecWithCount()
+	}
+
+	count, err := increment()
+	if err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	if count == 0 {
+		//#nosec G201 -- TableName is static
+		err := sqlcon.HandleError(conn.RawQuery(fmt.Sprintf(
+			"INSERT INTO %s (id, nid, identifier, failed_attempts, locked_until, created_at, updated_at) VALUES (?, ?, ?, 1, NULL, ?, ?)", table),
+			x.NewUUID(), nid, identifier, now, now,
+		).Exec())
+		if errors.Is(err, sqlcon.ErrUniqueViolation) {
+			// The tracker was created concurrently, so we count on the existing row.
+			_, err = increment()
+			err = sqlcon.HandleError(err)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	//#nosec G201 -- TableName is static
+	if err := conn.RawQuery(fmt.Sprintf(
+		"UPDATE %s SET locked_until = ? WHERE nid = ? AND identifier = ? AND locked_until IS NULL AND failed_attempts >= ?", table),
+		now.Add(lockout), nid, identifier, maxAttempts,
+	).Exec(); err != nil {
+		return nil, sqlcon.HandleError(err)
+	}
+
+	return p.GetLoginAttempts(ctx, identifier)
+}
+
+func (p *Persister) ClearLoginAttempts(ctx context.Context, identifier string) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.ClearLoginAttempts")
+	defer otelx.End(span, &err)
+
+	//#nosec G201 -- TableName is static
+	return sqlcon.HandleError(p.GetConnection(ctx).RawQuery(
+		fmt.Sprintf("DELETE FROM %s WHERE nid = ? AND identifier = ?", new(password.LoginAttempts).TableName(ctx)),
+		p.NetworkID(ctx),
+		idpersistence.NormalizeIdentifier(identity.CredentialsTypePassword, identifier),
+	).Exec())
+}
+
+func (p *Persister) DeleteStaleLoginAttempts(ctx context.Context, olderThan time.Time, limit int) (err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.DeleteStaleLoginAttempts")
+	defer otelx.End(span, &err)
+
+	//#nosec G201 -- TableName is static
+	return sqlcon.HandleError(p.GetConnection(ctx).RawQuery(fmt.Sprintf(
+		"DELETE FROM %s WHERE id in (SELECT id FROM (SELECT id FROM %s c WHERE updated_at < ? AND (locked_until IS NULL OR locked_until < ?) AND nid = ? ORDER BY updated_at ASC LIMIT %d ) AS s )",
+		new(password.LoginAttempts).TableName(ctx),
+		new(password.LoginAttempts).TableName(ctx),
+		limit,
+	),
+		olderThan,
+		olderThan,
+		p.NetworkID(ctx),
+	).Exec())
+}
@@ -42,6 +42,7 @@ import (
 	sessiontokenexchange "github.com/ory/kratos/selfservice/sessiontokenexchange/test"
 	code "github.com/ory/kratos/selfservice/strategy/code/test"
 	link "github.com/ory/kratos/selfservice/strategy/link/test"
+	password "github.com/ory/kratos/selfservice/strategy/password/test"
 	session "github.com/ory/kratos/session/test"
 	"github.com/ory/kratos/x"
 	"github.com/ory/kratos/x/xsql"
@@ -261,6 +262,10 @@ func TestPersister(t *testing.T) {
 				t.Parallel()
 				code.TestPersister(ctx, p)(t)
 			})
+			t.Run("contract=password.TestPersister", func(t *testing.T) {
+				t.Parallel()
+				password.TestPersister(ctx, p)(t)
+			})
 			t.Run("contract=continuity.TestPersister", func(t *testing.T) {
 				t.Parallel()
 				continuity.TestPersister(ctx, p)(t)
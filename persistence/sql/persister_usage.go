@@ -0,0 +1,62 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package sql
+
+import (
+	"context"
+	"time"
+
+	"github.com/ory/x/otelx"
+	"github.com/ory/x/sqlcon"
+
+	"github.com/ory/kratos/courier"
+	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/session"
+	"github.com/ory/kratos/usage"
+)
+
+var _ usage.Persister = new(Persister)
+
+func (p *Persister) UsageReport(ctx context.Context, from, to time.Time) (_ *usage.Report, err error) {
+	ctx, span := p.r.Tracer(ctx).Tracer().Start(ctx, "persistence.sql.UsageReport")
+	defer otelx.End(span, &err)
+
+	nid := p.NetworkID(ctx)
+	conn := p.GetConnection(ctx)
+	report := &usage.Report{From: from, To: to}
+
+	// All window queries are served by the (nid, created_at) indexes on
+	// sessions and courier_messages.
+	if report.ActiveIdentities, err = countInt64(conn.
+		Where("nid = ? AND state = ? AND created_at < ?", nid, identity.StateActive, to).
+		Count(new(identity.Identity))); err != nil {
+		return nil, err
+	}
+
+	sessions := conn.Where("nid = ? AND created_at >= ? AND created_at < ?", nid, from, to)
+	if report.SessionsIssued, err = countInt64(sessions.Count(new(session.Session))); err != nil {
+		return nil, err
+	}
+	if report.AuthenticatedIdentities, err = countInt64(sessions.CountByField(new(session.Session), "DISTINCT identity_id")); err != nil {
+		return nil, err
+	}
+
+	messages := func(t courier.MessageType) (int64, error) {
+		return countInt64(conn.
+			Where("nid = ? AND created_at >= ? AND created_at < ? AND status = ? AND type = ?", nid, from, to, courier.MessageStatusSent, t).
+			Count(new(courier.Message)))
+	}
+	if report.EmailsSent, err = messages(courier.MessageTypeEmail); err != nil {
+		return nil, err
+	}
+	if report.SMSSent, err = messages(courier.MessageTypeSMS); err != nil {
+		return nil, err
+	}
+
+	return report, nil
+}
+
+func countInt64(count int, err error) (int64, error) {
+	return int64(count), sqlcon.HandleError(err)
+}
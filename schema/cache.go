@@ -0,0 +1,142 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-retryablehttp"
+	"github.com/pkg/errors"
+
+	"github.com/ory/jsonschema/v3"
+	"github.com/ory/jsonschema/v3/httploader"
+	"github.com/ory/kratos/driver/config"
+)
+
+type (
+	// remoteSchemaCache caches schemas loaded from http:// and https:// URLs.
+	// Entries are revalidated with conditional requests once their TTL
+	// expired, and served stale while that happens in the background.
+	remoteSchemaCache struct {
+		sync.Mutex
+		entries map[string]*remoteSchema
+		now     func() time.Time
+	}
+	remoteSchema struct {
+		body         []byte
+		etag         string
+		lastModified string
+		fetchedAt    time.Time
+		revalidating bool
+	}
+)
+
+func newRemoteSchemaCache() *remoteSchemaCache {
+	return &remoteSchemaCache{entries: map[string]*remoteSchema{}, now: time.Now}
+}
+
+// load returns the document at href. Documents which are not loaded over HTTP,
+// or all documents if the cache is disabled, are passed to jsonschema.LoadURL.
+func (c *remoteSchemaCache) load(ctx context.Context, conf config.IdentitySchemaCache, href string) (io.ReadCloser, error) {
+	u, err := url.Parse(href)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || conf.TTL <= 0 {
+		return jsonschema.LoadURL(ctx, href)
+	}
+
+	c.Lock()
+	if e, ok := c.entries[href]; ok {
+		age := c.now().Sub(e.fetchedAt)
+		if age < conf.TTL+conf.StaleWhileRevalidate {
+			if age >= conf.TTL && !e.revalidating {
+				e.revalidating = true
+				go func() { _, _ = c.fetch(context.WithoutCancel(ctx), href) }()
+			}
+			body := e.body
+			c.Unlock()
+			return io.NopCloser(bytes.NewReader(body)), nil
+		}
+	}
+	c.Unlock()
+
+	body, err := c.fetch(ctx, href)
+	if err != nil {
+		return nil, err
+	}
+	return io.NopCloser(bytes.NewReader(body)), nil
+}
+
+// fetch loads href, sending a conditional request if a cached copy exists,
+// and stores the result.
+func (c *remoteSchemaCache) fetch(ctx context.Context, href string) (_ []byte, err error) {
+	c.Lock()
+	var etag, lastModified string
+	if e, ok := c.entries[href]; ok {
+		etag, lastModified = e.etag, e.lastModified
+	}
+	c.Unlock()
+
+	defer func() {
+		if err != nil {
+			c.Lock()
+			if e, ok := c.entries[href]; ok {
+				e.revalidating = false
+			}
+			c.Unlock()
+		}
+	}()
+
+	hc, ok := ctx.Value(httploader.ContextKey).(*retryablehttp.Client)
+	if !ok {
+		return nil, errors.Errorf("expected a client to be set for %s", httploader.ContextKey)
+	}
+
+	req, err := retryablehttp.NewRequestWithContext(ctx, "GET", href, nil)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	res, err := hc.Do(req)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	defer res.Body.Close()
+
+	var body []byte
+	if res.StatusCode == http.StatusOK {
+		if body, err = io.ReadAll(res.Body); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	c.Lock()
+	defer c.Unlock()
+
+	switch e, ok := c.entries[href]; {
+	case res.StatusCode == http.StatusNotModified && ok:
+		e.fetchedAt, e.revalidating = c.now(), false
+		return e.body, nil
+	case res.StatusCode != http.StatusOK:
+		return nil, errors.Errorf("%s returned status code %d", href, res.StatusCode)
+	}
+
+	c.entries[href] = &remoteSchema{
+		body:         body,
+		etag:         res.Header.Get("ETag"),
+		lastModified: res.Header.Get("Last-Modified"),
+		fetchedAt:    c.now(),
+	}
+	return body, nil
+}
@@ -0,0 +1,119 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package schema
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/herodot"
+	"github.com/ory/jsonschema/v3/httploader"
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/x/httpx"
+)
+
+func TestRemoteSchemaCache(t *testing.T) {
+	schema, err := os.ReadFile("stub/validator/firstName.schema.json")
+	require.NoError(t, err)
+
+	var requests, conditional atomic.Int32
+	var failing atomic.Bool
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests.Add(1)
+		if failing.Load() {
+			w.WriteHeader(http.StatusBadGateway)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			conditional.Add(1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write(schema)
+	}))
+	t.Cleanup(ts.Close)
+
+	var mu sync.Mutex
+	now := time.Now()
+	advance := func(d time.Duration) {
+		mu.Lock()
+		defer mu.Unlock()
+		now = now.Add(d)
+	}
+
+	v := NewValidator()
+	v.cache.now = func() time.Time {
+		mu.Lock()
+		defer mu.Unlock()
+		return now
+	}
+
+	ctx := context.WithValue(context.Background(), httploader.ContextKey, httpx.NewResilientClient(httpx.ResilientClientWithMaxRetry(0)))
+	conf := config.IdentitySchemaCache{TTL: time.Minute, StaleWhileRevalidate: time.Hour}
+	doc := json.RawMessage(`{ "firstName": "first-name", "lastName": "last-name", "age": 1 }`)
+	validate := func(conf config.IdentitySchemaCache) error {
+		return v.Validate(ctx, ts.URL+"/schema.json", doc, WithRemoteSchemaCache(conf))
+	}
+
+	t.Run("case=fetches every time if disabled", func(t *testing.T) {
+		require.NoError(t, validate(config.IdentitySchemaCache{}))
+		require.NoError(t, validate(config.IdentitySchemaCache{}))
+		assert.EqualValues(t, 2, requests.Load())
+	})
+
+	requests.Store(0)
+
+	t.Run("case=serves fresh entries from the cache", func(t *testing.T) {
+		require.NoError(t, validate(conf))
+		require.NoError(t, validate(conf))
+		assert.EqualValues(t, 1, requests.Load())
+	})
+
+	t.Run("case=revalidates stale entries in the background", func(t *testing.T) {
+		advance(2 * time.Minute)
+		require.NoError(t, validate(conf))
+		require.Eventually(t, func() bool { return conditional.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+
+		// The 304 refreshed the entry, so it is fresh again.
+		require.Eventually(t, func() bool {
+			v.cache.Lock()
+			defer v.cache.Unlock()
+			return !v.cache.entries[ts.URL+"/schema.json"].revalidating
+		}, 5*time.Second, 10*time.Millisecond)
+		require.NoError(t, validate(conf))
+		assert.EqualValues(t, 2, requests.Load())
+	})
+
+	t.Run("case=serves stale entries while the schema server fails", func(t *testing.T) {
+		failing.Store(true)
+		t.Cleanup(func() { failing.Store(false) })
+
+		advance(30 * time.Minute)
+		require.NoError(t, validate(conf))
+		require.NoError(t, validate(conf))
+
+		t.Run("case=fails after the stale window", func(t *testing.T) {
+			advance(2 * time.Hour)
+			err := validate(conf)
+			require.Error(t, err)
+
+			var he *herodot.DefaultError
+			require.True(t, errors.As(err, &he), "%+v", err)
+			assert.Equal(t, http.StatusInternalServerError, he.StatusCode())
+			assert.Contains(t, he.Reason(), ts.URL+"/schema.json")
+		})
+	})
+}
@@ -122,6 +122,16 @@ func NewInvalidCredentialsError() error {
 	})
 }
 
+func NewAccountLockedError() error {
+	return errors.WithStack(&ValidationError{
+		ValidationError: &jsonschema.ValidationError{
+			Message:     "too many failed login attempts, please try again later",
+			InstancePtr: "#/",
+		},
+		Messages: new(text.Messages).Add(text.NewErrorValidationAccountLocked()),
+	})
+}
+
 func NewAccountNotFoundError() error {
 	return errors.WithStack(&ValidationError{
 		ValidationError: &jsonschema.ValidationError{
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"io"
 	"sync"
 
 	"github.com/pkg/errors"
@@ -14,10 +15,12 @@ import (
 	"github.com/ory/herodot"
 
 	"github.com/ory/jsonschema/v3"
+	"github.com/ory/kratos/driver/config"
 )
 
 type Validator struct {
 	sync.RWMutex
+	cache *remoteSchemaCache
 }
 
 type ValidationProvider interface {
@@ -25,11 +28,12 @@ type ValidationProvider interface {
 }
 
 func NewValidator() *Validator {
-	return &Validator{}
+	return &Validator{cache: newRemoteSchemaCache()}
 }
 
 type validatorOptions struct {
-	e *ExtensionRunner
+	e     *ExtensionRunner
+	cache config.IdentitySchemaCache
 }
 
 func WithExtensionRunner(e *ExtensionRunner) func(*validatorOptions) {
@@ -38,6 +42,13 @@ func WithExtensionRunner(e *ExtensionRunner) func(*validatorOptions) {
 	}
 }
 
+// WithRemoteSchemaCache caches schemas loaded over HTTP for the given TTL.
+func WithRemoteSchemaCache(conf config.IdentitySchemaCache) func(*validatorOptions) {
+	return func(o *validatorOptions) {
+		o.cache = conf
+	}
+}
+
 func (v *Validator) Validate(
 	ctx context.Context,
 	href string,
@@ -50,9 +61,15 @@ func (v *Validator) Validate(
 	}
 
 	compiler := jsonschema.NewCompiler()
-	resource, err := jsonschema.LoadURL(ctx, href)
+	if v.cache != nil {
+		compiler.LoadURL = func(ctx context.Context, s string) (io.ReadCloser, error) {
+			return v.cache.load(ctx, o.cache, s)
+		}
+	}
+
+	resource, err := compiler.LoadURL(ctx, href)
 	if err != nil {
-		return errors.WithStack(herodot.ErrInternalServerError.WithReasonf("Unable to parse validate JSON object against JSON schema.").WithDebugf("%s", err))
+		return errors.WithStack(herodot.ErrInternalServerError.WithReasonf("Unable to load the JSON schema %s.", href).WithDebugf("%s", err))
 	}
 
 	if o.e != nil {
@@ -6,6 +6,7 @@ package errorx
 import (
 	"encoding/json"
 	"net/http"
+	"strings"
 
 	"github.com/ory/x/stringsx"
 
@@ -101,16 +102,25 @@ func (h *Handler) fetchError(w http.ResponseWriter, r *http.Request) error {
 		stringsx.Coalesce(
 			r.URL.Query().Get("error"), // https://github.com/ory/kratos/issues/1507
 			r.URL.Query().Get("id"))
+	idPrefix, strip := x.ErrorOptions(h.r.Config(), r)
+
+	var es *ErrorContainer
 	switch id {
 	case "stub:500":
-		h.r.Writer().Write(w, r, &ErrorContainer{ID: x.NewUUID(), Errors: stub500})
-		return nil
+		es = &ErrorContainer{ID: x.NewUUID(), Errors: stub500}
+	default:
+		var err error
+		es, err = h.r.SelfServiceErrorPersister().ReadErrorContainer(r.Context(), x.ParseUUID(strings.TrimPrefix(id, idPrefix)))
+		if err != nil {
+			return err
+		}
 	}
 
-	es, err := h.r.SelfServiceErrorPersister().ReadErrorContainer(r.Context(), x.ParseUUID(id))
+	errs, err := x.ApplyErrorOptions(es.Errors, "", idPrefix, strip)
 	if err != nil {
 		return err
 	}
+	es.Errors = errs
 
 	h.r.Writer().Write(w, r, es)
 	return nil
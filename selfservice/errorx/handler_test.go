@@ -10,6 +10,7 @@ import (
 	"io"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"testing"
 
 	"github.com/ory/x/assertx"
@@ -21,6 +22,7 @@ import (
 	"github.com/tidwall/gjson"
 
 	"github.com/ory/herodot"
+	"github.com/ory/kratos/driver/config"
 	"github.com/ory/kratos/internal"
 	"github.com/ory/kratos/selfservice/errorx"
 	"github.com/ory/kratos/x"
@@ -29,7 +31,7 @@ import (
 )
 
 func TestHandler(t *testing.T) {
-	_, reg := internal.NewFastRegistryWithMocks(t)
+	conf, reg := internal.NewFastRegistryWithMocks(t)
 	h := errorx.NewHandler(reg)
 
 	t.Run("case=public authorization", func(t *testing.T) {
@@ -129,4 +131,82 @@ func TestHandler(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("case=error options", func(t *testing.T) {
+		ctx := context.Background()
+		conf.MustSet(ctx, config.ViperKeyErrorsIDPrefix, "acme-err-")
+		conf.MustSet(ctx, config.ViperKeyErrorsIncludeDebug, false)
+		t.Cleanup(func() {
+			conf.MustSet(ctx, config.ViperKeyErrorsIDPrefix, "")
+			conf.MustSet(ctx, config.ViperKeyErrorsIncludeDebug, true)
+		})
+
+		gave := herodot.ErrNotFound.WithID("not_here").WithReason("foobar").WithDebug("baz")
+
+		public := x.NewRouterPublic()
+		h.RegisterPublicRoutes(public)
+		public.GET("/write-error", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+			reg.Writer().WriteError(w, r, gave)
+		})
+		publicTS := httptest.NewServer(public)
+		defer publicTS.Close()
+
+		admin := x.NewRouterAdmin()
+		admin.GET("/write-error", func(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+			reg.Writer().WriteError(w, r, gave)
+		})
+		adminTS := httptest.NewServer(admin)
+		defer adminTS.Close()
+
+		get := func(t *testing.T, u string, expectedCode int) []byte {
+			res, err := http.Get(u)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			require.EqualValues(t, expectedCode, res.StatusCode)
+			body, err := io.ReadAll(res.Body)
+			require.NoError(t, err)
+			return body
+		}
+
+		t.Run("case=self-service error round-trips with prefixed id", func(t *testing.T) {
+			to, err := reg.SelfServiceErrorManager().Create(ctx, httptest.NewRecorder(), httptest.NewRequest("GET", "/", nil), gave)
+			require.NoError(t, err)
+			u, err := url.Parse(to)
+			require.NoError(t, err)
+			id := u.Query().Get("id")
+			require.Regexp(t, "^acme-err-", id)
+
+			actual := get(t, publicTS.URL+errorx.RouteGet+"?id="+url.QueryEscape(id), http.StatusOK)
+			assert.EqualValues(t, "acme-err-not_here", gjson.GetBytes(actual, "error.id").String(), "%s", actual)
+			assert.False(t, gjson.GetBytes(actual, "error.reason").Exists(), "%s", actual)
+			assert.False(t, gjson.GetBytes(actual, "error.debug").Exists(), "%s", actual)
+
+			// The unprefixed id keeps working.
+			_ = get(t, publicTS.URL+errorx.RouteGet+"?id="+id[len("acme-err-"):], http.StatusOK)
+		})
+
+		t.Run("case=public responses are stripped", func(t *testing.T) {
+			actual := get(t, publicTS.URL+"/write-error", http.StatusNotFound)
+			assert.EqualValues(t, "acme-err-not_here", gjson.GetBytes(actual, "error.id").String(), "%s", actual)
+			assert.False(t, gjson.GetBytes(actual, "error.reason").Exists(), "%s", actual)
+			assert.False(t, gjson.GetBytes(actual, "error.debug").Exists(), "%s", actual)
+			assert.EqualValues(t, http.StatusNotFound, gjson.GetBytes(actual, "error.code").Int(), "%s", actual)
+		})
+
+		t.Run("case=admin responses are never stripped", func(t *testing.T) {
+			actual := get(t, adminTS.URL+x.AdminPrefix+"/write-error", http.StatusNotFound)
+			assert.EqualValues(t, "acme-err-not_here", gjson.GetBytes(actual, "error.id").String(), "%s", actual)
+			assert.EqualValues(t, "foobar", gjson.GetBytes(actual, "error.reason").String(), "%s", actual)
+			assert.False(t, gjson.GetBytes(actual, "error.debug").Exists(), "%s", actual)
+		})
+
+		t.Run("case=settings are reloaded", func(t *testing.T) {
+			conf.MustSet(ctx, config.ViperKeyErrorsIDPrefix, "")
+			conf.MustSet(ctx, config.ViperKeyErrorsIncludeDebug, true)
+
+			actual := get(t, publicTS.URL+"/write-error", http.StatusNotFound)
+			assert.EqualValues(t, "not_here", gjson.GetBytes(actual, "error.id").String(), "%s", actual)
+			assert.EqualValues(t, "foobar", gjson.GetBytes(actual, "error.reason").String(), "%s", actual)
+		})
+	})
 }
@@ -48,7 +48,7 @@ func (m *Manager) Create(ctx context.Context, w http.ResponseWriter, r *http.Req
 		return "", addErr
 	}
 	q := url.Values{}
-	q.Set("id", id.String())
+	q.Set("id", m.d.Config().ErrorsIDPrefix(ctx)+id.String())
 
 	return urlx.CopyWithQuery(m.d.Config().SelfServiceFlowErrorURL(ctx), q).String(), nil
 }
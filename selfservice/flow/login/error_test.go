@@ -204,6 +204,40 @@ func TestHandleError(t *testing.T) {
 		})
 	}
 
+	t.Run("flow=api", func(t *testing.T) {
+		t.Run("case=expired error with message override", func(t *testing.T) {
+			t.Cleanup(reset)
+
+			getExpiredFlow := func(t *testing.T) []byte {
+				loginFlow = newFlow(t, time.Minute, flow.TypeAPI)
+				flowError = flow.NewFlowExpiredError(anHourAgo)
+				ct = node.PasswordGroup
+
+				res, err := ts.Client().Do(testhelpers.NewHTTPGetJSONRequest(t, ts.URL+"/error"))
+				require.NoError(t, err)
+				defer res.Body.Close()
+				body, err := io.ReadAll(res.Body)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusGone, res.StatusCode, "%s", body)
+
+				res, err = public.Client().Get(public.URL + login.RouteGetFlow + "?id=" + gjson.GetBytes(body, "use_flow_id").String())
+				require.NoError(t, err)
+				defer res.Body.Close()
+				body, err = io.ReadAll(res.Body)
+				require.NoError(t, err)
+				require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+				require.Equal(t, int(text.ErrorValidationLoginFlowExpired), int(gjson.GetBytes(body, "ui.messages.0.id").Int()), "%s", body)
+				return body
+			}
+
+			conf.MustSet(ctx, config.ViperKeyUIMessageOverrides, map[string]any{"4010001": "Please sign in again."})
+			assert.Equal(t, "Please sign in again.", gjson.GetBytes(getExpiredFlow(t), "ui.messages.0.text").String())
+
+			conf.MustSet(ctx, config.ViperKeyUIMessageOverrides, nil)
+			assert.Contains(t, gjson.GetBytes(getExpiredFlow(t), "ui.messages.0.text").String(), "The login flow expired")
+		})
+	})
+
 	t.Run("flow=browser", func(t *testing.T) {
 		expectLoginUI := func(t *testing.T) (*login.Flow, *http.Response) {
 			res, err := http.DefaultClient.Get(ts.URL + "/error")
@@ -29,6 +29,7 @@ var (
 	ErrHookAbortFlow        = errors.New("aborted registration hook execution")
 	ErrAlreadyLoggedIn      = herodot.ErrBadRequest.WithID(text.ErrIDAlreadyLoggedIn).WithError("you are already logged in").WithReason("A valid session was detected and thus registration is not possible.")
 	ErrRegistrationDisabled = herodot.ErrBadRequest.WithID(text.ErrIDSelfServiceFlowDisabled).WithError("registration flow disabled").WithReason("Registration is not allowed because it was disabled.")
+	ErrQuotaExceeded        = herodot.ErrForbidden.WithID(text.ErrIDIdentityQuotaExceeded).WithError("identity quota exceeded").WithReason("Registration is not possible because the maximum number of identities was reached.")
 )
 
 type (
@@ -55,6 +55,7 @@ type (
 		FlowPersistenceProvider
 		ErrorHandlerProvider
 		sessiontokenexchange.PersistenceProvider
+		identity.PrivilegedPoolProvider
 		x.LoggingProvider
 	}
 	HandlerProvider interface {
@@ -119,6 +120,10 @@ func (h *Handler) NewRegistrationFlow(w http.ResponseWriter, r *http.Request, ft
 		return nil, errors.WithStack(ErrRegistrationDisabled)
 	}
 
+	if err := checkQuota(r.Context(), h.d); err != nil {
+		return nil, err
+	}
+
 	f, err := NewFlow(h.d.Config(), h.d.Config().SelfServiceFlowRegistrationRequestLifespan(r.Context()), h.d.GenerateCSRFToken(r), r, ft)
 	if err != nil {
 		return nil, err
@@ -316,6 +316,42 @@ func TestDisabledFlow(t *testing.T) {
 	})
 }
 
+func TestIdentityQuota(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+
+	conf.MustSet(ctx, config.ViperKeySelfServiceRegistrationMaxIdentities, 1)
+	testhelpers.SetDefaultIdentitySchema(conf, "file://./stub/login.schema.json")
+	conf.MustSet(ctx, config.ViperKeySelfServiceStrategyConfig+"."+string(identity.CredentialsTypePassword),
+		map[string]interface{}{"enabled": true})
+
+	publicTS, _ := testhelpers.NewKratosServerWithCSRF(t, reg)
+
+	initFlow := func(t *testing.T) (*http.Response, []byte) {
+		res, err := publicTS.Client().Get(publicTS.URL + registration.RouteInitAPIFlow)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, body
+	}
+
+	res, body := initFlow(t)
+	assert.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+
+	require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(ctx, &identity.Identity{
+		ID: x.NewUUID(), Traits: identity.Traits(`{}`), State: identity.StateActive,
+	}))
+
+	res, body = initFlow(t)
+	assert.Equal(t, http.StatusForbidden, res.StatusCode)
+	assertx.EqualAsJSON(t, registration.ErrQuotaExceeded, json.RawMessage(gjson.GetBytes(body, "error").Raw), "%s", body)
+
+	conf.MustSet(ctx, config.ViperKeySelfServiceRegistrationMaxIdentities, 0)
+	res, body = initFlow(t)
+	assert.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+}
+
 func TestGetFlow(t *testing.T) {
 	ctx := context.Background()
 	conf, reg := internal.NewFastRegistryWithMocks(t)
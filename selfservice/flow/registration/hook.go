@@ -152,6 +152,9 @@ func (e *HookExecutor) PostRegistrationHook(w http.ResponseWriter, r *http.Reque
 	if err := e.d.IdentityValidator().Validate(ctx, i); err != nil {
 		return err
 	}
+	if err := checkQuota(ctx, e.d); err != nil {
+		return err
+	}
 	// We're now creating the identity because any of the hooks could trigger a "redirect" or a "session" which
 	// would imply that the identity has to exist already.
 	if err := e.d.IdentityManager().Create(ctx, i); err != nil {
@@ -0,0 +1,35 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package registration
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
+)
+
+// checkQuota returns ErrQuotaExceeded if
+// `selfservice.flows.registration.max_identities` is set and reached.
+func checkQuota(ctx context.Context, d interface {
+	config.Provider
+	identity.PrivilegedPoolProvider
+},
+) error {
+	limit := d.Config().SelfServiceFlowRegistrationMaxIdentities(ctx)
+	if limit <= 0 {
+		return nil
+	}
+
+	count, err := d.PrivilegedIdentityPool().CountIdentities(ctx)
+	if err != nil {
+		return err
+	}
+	if count >= limit {
+		return errors.WithStack(ErrQuotaExceeded)
+	}
+	return nil
+}
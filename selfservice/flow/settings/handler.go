@@ -578,6 +578,11 @@ func (h *Handler) updateSettingsFlow(w http.ResponseWriter, r *http.Request, ps
 		return
 	}
 
+	if ss.IsImpersonated() {
+		h.d.SettingsFlowErrorHandler().WriteFlowError(w, r, node.DefaultGroup, f, nil, errors.WithStack(session.ErrImpersonatedSession))
+		return
+	}
+
 	requestURL := x.RequestURL(r).String()
 	if err := h.d.SessionManager().DoesSessionSatisfy(r, ss, h.d.Config().SelfServiceSettingsRequiredAAL(r.Context()), session.WithRequestURL(requestURL)); err != nil {
 		h.d.SettingsFlowErrorHandler().WriteFlowError(w, r, node.DefaultGroup, f, nil, err)
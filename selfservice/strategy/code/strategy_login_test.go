@@ -600,6 +600,38 @@ func TestLoginCodeStrategy(t *testing.T) {
 				})
 			})
 
+			t.Run("case=should respect the configured maximum submissions", func(t *testing.T) {
+				conf.MustSet(ctx, config.ViperKeyCodeMaxSubmissions, 2)
+				t.Cleanup(func() {
+					conf.MustSet(ctx, config.ViperKeyCodeMaxSubmissions, 5)
+				})
+
+				s := createLoginFlow(ctx, t, public, tc.apiType, false)
+				s = submitLogin(ctx, t, s, tc.apiType, func(v *url.Values) {
+					v.Set("identifier", s.identityEmail)
+				}, false, nil)
+
+				message := testhelpers.CourierExpectMessage(ctx, t, reg, s.identityEmail, "Use code")
+				loginCode := testhelpers.CourierExpectCodeInMessage(t, message, 1)
+				assert.NotEmpty(t, loginCode)
+
+				for i := 0; i < 2; i++ {
+					s = submitLogin(ctx, t, s, tc.apiType, func(v *url.Values) {
+						v.Set("code", "111111")
+						v.Set("identifier", s.identityEmail)
+					}, false, func(t *testing.T, s *state, body string, resp *http.Response) {
+						assert.Contains(t, gjson.Get(body, "ui.messages.0.text").String(), "The login code is invalid or has already been used")
+					})
+				}
+
+				s = submitLogin(ctx, t, s, tc.apiType, func(v *url.Values) {
+					v.Set("code", loginCode)
+					v.Set("identifier", s.identityEmail)
+				}, false, func(t *testing.T, s *state, body string, resp *http.Response) {
+					assert.Contains(t, gjson.Get(body, "ui.messages.0.text").String(), "The request was submitted too often.")
+				})
+			})
+
 			t.Run("case=code should expire", func(t *testing.T) {
 				ctx := context.Background()
 
@@ -0,0 +1,132 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package password
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/pkg/errors"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/schema"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/sqlcon"
+)
+
+type (
+	// LoginAttempts tracks consecutive failed password logins for an identifier.
+	LoginAttempts struct {
+		// ID represents the tracker's unique ID.
+		ID uuid.UUID `json:"id" db:"id" faker:"-"`
+
+		// Identifier is the credentials identifier the attempts were made against.
+		Identifier string `json:"identifier" db:"identifier"`
+
+		// FailedAttempts counts the consecutive failed attempts since the last
+		// successful login or the last elapsed lockout.
+		FailedAttempts int `json:"failed_attempts" db:"failed_attempts"`
+
+		// LockedUntil is set once FailedAttempts reached the configured maximum.
+		LockedUntil sql.NullTime `json:"locked_until" db:"locked_until"`
+
+		// CreatedAt is a helper struct field for gobuffalo.pop.
+		CreatedAt time.Time `json:"created_at" db:"created_at"`
+
+		// UpdatedAt is a helper struct field for gobuffalo.pop.
+		UpdatedAt time.Time `json:"updated_at" db:"updated_at"`
+
+		NID uuid.UUID `json:"-" faker:"-" db:"nid"`
+	}
+
+	LoginAttemptsPersister interface {
+		// GetLoginAttempts returns the tracker for the identifier or sqlcon.ErrNoRows.
+		GetLoginAttempts(ctx context.Context, identifier string) (*LoginAttempts, error)
+
+		// RecordFailedLoginAttempt increments the failed attempts of the identifier
+		// and locks it until `now + lockout` once `maxAttempts` is reached.
+		RecordFailedLoginAttempt(ctx context.Context, identifier string, now time.Time, maxAttempts int, lockout time.Duration) (*LoginAttempts, error)
+
+		// ClearLoginAttempts removes the tracker of the identifier.
+		ClearLoginAttempts(ctx context.Context, identifier string) error
+
+		// DeleteStaleLoginAttempts removes trackers which have not been updated since `olderThan`
+		// and are not locked any more.
+		DeleteStaleLoginAttempts(ctx context.Context, olderThan time.Time, limit int) error
+	}
+
+	LoginAttemptsPersistenceProvider interface {
+		LoginAttemptsPersister() LoginAttemptsPersister
+	}
+)
+
+func (LoginAttempts) TableName(context.Context) string {
+	return "selfservice_password_login_attempts"
+}
+
+// IsLocked returns true if the identifier is locked at the given time.
+func (a *LoginAttempts) IsLocked(now time.Time) bool {
+	return a.LockedUntil.Valid && a.LockedUntil.Time.After(now)
+}
+
+func lockoutError(conf *config.PasswordLockout) error {
+	if conf.RevealLockout {
+		return errors.WithStack(schema.NewAccountLockedError())
+	}
+	return errors.WithStack(schema.NewInvalidCredentialsError())
+}
+
+// checkLockout returns an error if the identifier is currently locked.
+func (s *Strategy) checkLockout(ctx context.Context, conf *config.PasswordLockout, identifier string) error {
+	if conf.MaxAttempts == 0 {
+		return nil
+	}
+
+	attempts, err := s.d.LoginAttemptsPersister().GetLoginAttempts(ctx, identifier)
+	if errors.Is(err, sqlcon.ErrNoRows) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if attempts.IsLocked(s.now()) {
+		time.Sleep(x.RandomDelay(s.d.Config().HasherArgon2(ctx).ExpectedDuration, s.d.Config().HasherArgon2(ctx).ExpectedDeviation))
+		return lockoutError(conf)
+	}
+
+	return nil
+}
+
+// recordFailedAttempt counts a failed login for the identifier. Attempts against
+// identifiers without an account are counted as well, so that locked responses
+// do not reveal which accounts exist. The returned error is the one the caller
+// should surface to the user.
+func (s *Strategy) recordFailedAttempt(ctx context.Context, conf *config.PasswordLockout, identifier string) error {
+	if conf.MaxAttempts == 0 {
+		return errors.WithStack(schema.NewInvalidCredentialsError())
+	}
+
+	now := s.now()
+	attempts, err := s.d.LoginAttemptsPersister().RecordFailedLoginAttempt(ctx, identifier, now, conf.MaxAttempts, conf.LockoutDuration)
+	if err != nil {
+		return err
+	}
+
+	if attempts.IsLocked(now) {
+		return lockoutError(conf)
+	}
+
+	return errors.WithStack(schema.NewInvalidCredentialsError())
+}
+
+// clearAttempts resets the failed login counter after a successful login.
+func (s *Strategy) clearAttempts(ctx context.Context, conf *config.PasswordLockout, identifier string) error {
+	if conf.MaxAttempts == 0 {
+		return nil
+	}
+
+	return s.d.LoginAttemptsPersister().ClearLoginAttempts(ctx, identifier)
+}
@@ -78,10 +78,15 @@ func (s *Strategy) Login(w http.ResponseWriter, r *http.Request, f *login.Flow,
 	}
 
 	identifier := stringsx.Coalesce(p.Identifier, p.LegacyIdentifier)
+	lockout := s.d.Config().PasswordLockoutConfig(ctx)
+	if err := s.checkLockout(ctx, lockout, identifier); err != nil {
+		return nil, s.handleLoginError(r, f, p, err)
+	}
+
 	i, c, err := s.d.PrivilegedIdentityPool().FindByCredentialsIdentifier(ctx, s.ID(), identifier)
 	if err != nil {
 		time.Sleep(x.RandomDelay(s.d.Config().HasherArgon2(ctx).ExpectedDuration, s.d.Config().HasherArgon2(ctx).ExpectedDeviation))
-		return nil, s.handleLoginError(r, f, p, errors.WithStack(schema.NewInvalidCredentialsError()))
+		return nil, s.handleLoginError(r, f, p, s.recordFailedAttempt(ctx, lockout, identifier))
 	}
 
 	var o identity.CredentialsPassword
@@ -98,6 +103,10 @@ func (s *Strategy) Login(w http.ResponseWriter, r *http.Request, f *login.Flow,
 
 		migrationHook := hook.NewPasswordMigrationHook(s.d, pwHook.Config)
 		err = migrationHook.Execute(ctx, &hook.PasswordMigrationRequest{Identifier: identifier, Password: p.Password})
+		if errors.As(err, new(*schema.ValidationError)) {
+			// The hook rejected the password.
+			err = s.recordFailedAttempt(ctx, lockout, identifier)
+		}
 		if err != nil {
 			return nil, s.handleLoginError(r, f, p, err)
 		}
@@ -107,7 +116,7 @@ func (s *Strategy) Login(w http.ResponseWriter, r *http.Request, f *login.Flow,
 		}
 	} else {
 		if err := hash.Compare(ctx, []byte(p.Password), []byte(o.HashedPassword)); err != nil {
-			return nil, s.handleLoginError(r, f, p, errors.WithStack(schema.NewInvalidCredentialsError()))
+			return nil, s.handleLoginError(r, f, p, s.recordFailedAttempt(ctx, lockout, identifier))
 		}
 
 		if !s.d.Hasher(ctx).Understands([]byte(o.HashedPassword)) {
@@ -117,6 +126,10 @@ func (s *Strategy) Login(w http.ResponseWriter, r *http.Request, f *login.Flow,
 		}
 	}
 
+	if err := s.clearAttempts(ctx, lockout, identifier); err != nil {
+		return nil, s.handleLoginError(r, f, p, err)
+	}
+
 	f.Active = s.ID()
 	if err = s.d.LoginFlowPersister().UpdateLoginFlow(ctx, f); err != nil {
 		return nil, s.handleLoginError(r, f, p, errors.WithStack(herodot.ErrInternalServerError.WithReason("Could not update flow").WithDebug(err.Error())))
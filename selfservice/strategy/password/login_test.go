@@ -41,11 +41,13 @@ import (
 	"github.com/ory/kratos/internal/testhelpers"
 	"github.com/ory/kratos/schema"
 	"github.com/ory/kratos/selfservice/flow/login"
+	"github.com/ory/kratos/selfservice/strategy/password"
 	"github.com/ory/kratos/text"
 	"github.com/ory/kratos/x"
 	"github.com/ory/x/assertx"
 	"github.com/ory/x/errorsx"
 	"github.com/ory/x/ioutilx"
+	"github.com/ory/x/sqlcon"
 	"github.com/ory/x/sqlxx"
 	"github.com/ory/x/urlx"
 )
@@ -1104,6 +1106,113 @@ func TestCompleteLogin(t *testing.T) {
 			})
 		}
 	})
+
+}
+
+func TestCompleteLoginLockout(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	conf.MustSet(ctx, config.ViperKeySelfServiceStrategyConfig+"."+string(identity.CredentialsTypePassword),
+		map[string]interface{}{"enabled": true})
+	publicTS, _ := testhelpers.NewKratosServerWithRouters(t, reg, x.NewRouterPublic(), x.NewRouterAdmin())
+	_ = testhelpers.NewLoginUIFlowEchoServer(t, reg)
+	testhelpers.SetDefaultIdentitySchemaFromRaw(conf, loginSchema)
+	conf.MustSet(ctx, config.ViperKeySecretsDefault, []string{"not-a-secure-session-key"})
+
+	now := time.Now().UTC()
+	strategy, err := reg.AllLoginStrategies().Strategy(identity.CredentialsTypePassword)
+	require.NoError(t, err)
+	strategy.(*password.Strategy).SetNowFunc(func() time.Time { return now })
+
+	conf.MustSet(ctx, config.ViperKeyPasswordMaxAttempts, 2)
+	conf.MustSet(ctx, config.ViperKeyPasswordLockoutDuration, "10m")
+
+	withPassword := func(identifier, pwd string) func(v url.Values) {
+		return func(v url.Values) {
+			v.Set("identifier", identifier)
+			v.Set("password", pwd)
+		}
+	}
+
+	expectSuccess := func(t *testing.T, identifier, pwd string) {
+		body := testhelpers.SubmitLoginForm(t, true, nil, publicTS, withPassword(identifier, pwd),
+			false, false, http.StatusOK, publicTS.URL+login.RouteSubmitFlow)
+		assert.Equal(t, identifier, gjson.Get(body, "session.identity.traits.subject").String(), "%s", body)
+	}
+
+	expectMessage := func(t *testing.T, identifier, pwd string, expected *text.Message) {
+		body := testhelpers.SubmitLoginForm(t, true, nil, publicTS, withPassword(identifier, pwd),
+			false, false, http.StatusBadRequest, publicTS.URL+login.RouteSubmitFlow)
+		assert.Equal(t, expected.Text, gjson.Get(body, "ui.messages.0.text").String(), "%s", body)
+	}
+
+	lock := func(t *testing.T, identifier string) {
+		for i := 0; i < 2; i++ {
+			expectMessage(t, identifier, "not-password", text.NewErrorValidationInvalidCredentials())
+		}
+	}
+
+	t.Run("case=locked account rejects the correct password", func(t *testing.T) {
+		identifier, pwd := x.NewUUID().String(), "password"
+		createIdentity(ctx, reg, t, identifier, pwd)
+
+		lock(t, identifier)
+		expectMessage(t, identifier, pwd, text.NewErrorValidationInvalidCredentials())
+
+		now = now.Add(11 * time.Minute)
+		expectSuccess(t, identifier, pwd)
+	})
+
+	t.Run("case=reveals the lockout only if enabled", func(t *testing.T) {
+		conf.MustSet(ctx, config.ViperKeyPasswordRevealLockout, true)
+		t.Cleanup(func() { conf.MustSet(ctx, config.ViperKeyPasswordRevealLockout, false) })
+
+		identifier, pwd := x.NewUUID().String(), "password"
+		createIdentity(ctx, reg, t, identifier, pwd)
+
+		expectMessage(t, identifier, "not-password", text.NewErrorValidationInvalidCredentials())
+		expectMessage(t, identifier, "not-password", text.NewErrorValidationAccountLocked())
+		expectMessage(t, identifier, pwd, text.NewErrorValidationAccountLocked())
+
+		// Unknown identifiers are locked the same way, so the response does
+		// not reveal whether an account exists.
+		unknown := x.NewUUID().String()
+		expectMessage(t, unknown, "not-password", text.NewErrorValidationInvalidCredentials())
+		expectMessage(t, unknown, "not-password", text.NewErrorValidationAccountLocked())
+	})
+
+	t.Run("case=successful login clears the counter", func(t *testing.T) {
+		identifier, pwd := x.NewUUID().String(), "password"
+		createIdentity(ctx, reg, t, identifier, pwd)
+
+		expectMessage(t, identifier, "not-password", text.NewErrorValidationInvalidCredentials())
+		_, err := reg.Persister().GetLoginAttempts(ctx, identifier)
+		require.NoError(t, err)
+
+		expectSuccess(t, identifier, pwd)
+		_, err = reg.Persister().GetLoginAttempts(ctx, identifier)
+		require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+		// The counter starts from zero again.
+		expectMessage(t, identifier, "not-password", text.NewErrorValidationInvalidCredentials())
+		expectSuccess(t, identifier, pwd)
+	})
+
+	t.Run("case=max_attempts of zero disables the lockout", func(t *testing.T) {
+		conf.MustSet(ctx, config.ViperKeyPasswordMaxAttempts, 0)
+		t.Cleanup(func() { conf.MustSet(ctx, config.ViperKeyPasswordMaxAttempts, 2) })
+
+		identifier, pwd := x.NewUUID().String(), "password"
+		createIdentity(ctx, reg, t, identifier, pwd)
+
+		for i := 0; i < 5; i++ {
+			expectMessage(t, identifier, "not-password", text.NewErrorValidationInvalidCredentials())
+		}
+		_, err := reg.Persister().GetLoginAttempts(ctx, identifier)
+		require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+		expectSuccess(t, identifier, pwd)
+	})
 }
 
 func TestFormHydration(t *testing.T) {
@@ -0,0 +1,131 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package password
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/text"
+	"github.com/ory/kratos/x"
+)
+
+const RouteValidatePasswordPolicy = "/password-policy/validate"
+
+type (
+	policyHandlerDependencies interface {
+		x.WriterProvider
+		x.CSRFProvider
+		config.Provider
+		ValidationProvider
+	}
+	PolicyHandler struct {
+		r policyHandlerDependencies
+	}
+	PolicyHandlerProvider interface {
+		PasswordPolicyHandler() *PolicyHandler
+	}
+)
+
+func NewPolicyHandler(r policyHandlerDependencies) *PolicyHandler {
+	return &PolicyHandler{r: r}
+}
+
+func (h *PolicyHandler) RegisterPublicRoutes(public *x.RouterPublic) {
+	h.r.CSRFHandler().IgnoreGlobs(x.AdminPrefix+RouteValidatePasswordPolicy, RouteValidatePasswordPolicy)
+	public.POST(x.AdminPrefix+RouteValidatePasswordPolicy, x.RedirectToAdminRoute(h.r))
+}
+
+func (h *PolicyHandler) RegisterAdminRoutes(admin *x.RouterAdmin) {
+	admin.POST(RouteValidatePasswordPolicy, h.validatePasswordPolicy)
+}
+
+// Validate Password Policy Request
+//
+// swagger:parameters validatePasswordPolicy
+//
+//nolint:deadcode,unused
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type validatePasswordPolicy struct {
+	// in: body
+	// required: true
+	Body validatePasswordPolicyBody
+}
+
+// swagger:model validatePasswordPolicyBody
+type validatePasswordPolicyBody struct {
+	// The sample password to check.
+	//
+	// required: true
+	Password string `json:"password"`
+
+	// The identifier the password would be used with, for the similarity
+	// check.
+	Identifier string `json:"identifier"`
+}
+
+// Password Policy Validation Result
+//
+// swagger:model passwordPolicyValidation
+type passwordPolicyValidation struct {
+	// Whether the password satisfies the password policy.
+	//
+	// required: true
+	Valid bool `json:"valid"`
+
+	// The reason why the password was rejected.
+	Message *text.Message `json:"message,omitempty"`
+}
+
+// swagger:route POST /admin/password-policy/validate identity validatePasswordPolicy
+//
+// # Validate a Password Against the Password Policy
+//
+// Checks a sample password against the password policy of the
+// `selfservice.methods.password.config` section, including the
+// haveibeenpwned check if it is enabled. Nothing is stored.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Security:
+//	  oryAccessToken:
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: passwordPolicyValidation
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *PolicyHandler) validatePasswordPolicy(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	var body validatePasswordPolicyBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Unable to decode the request body: %s", err)))
+		return
+	}
+	if body.Password == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Field password must not be empty.")))
+		return
+	}
+
+	err := h.r.PasswordValidator().Validate(r.Context(), body.Identifier, body.Password)
+	var msg *text.Message
+	switch {
+	case err == nil:
+		h.r.Writer().Write(w, r, &passwordPolicyValidation{Valid: true})
+	case errors.As(err, &msg):
+		h.r.Writer().Write(w, r, &passwordPolicyValidation{Message: msg})
+	default:
+		h.r.Writer().WriteError(w, r, err)
+	}
+}
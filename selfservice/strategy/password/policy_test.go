@@ -0,0 +1,62 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package password_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/internal"
+	"github.com/ory/kratos/internal/testhelpers"
+	"github.com/ory/kratos/selfservice/strategy/password"
+	"github.com/ory/kratos/text"
+	"github.com/ory/kratos/x"
+)
+
+func TestValidatePasswordPolicy(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	conf.MustSet(ctx, config.ViperKeyPasswordHaveIBeenPwnedEnabled, false)
+	conf.MustSet(ctx, config.ViperKeyPasswordDisallowedWords, []string{"acme"})
+	_, adminTS := testhelpers.NewKratosServer(t, reg)
+
+	validate := func(t *testing.T, body string, expectedCode int) []byte {
+		res, err := adminTS.Client().Post(adminTS.URL+x.AdminPrefix+password.RouteValidatePasswordPolicy, "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		actual, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, expectedCode, res.StatusCode, "%s", actual)
+		return actual
+	}
+
+	t.Run("case=valid password", func(t *testing.T) {
+		actual := validate(t, `{"password":"l3f9toh1uaf81n21"}`, http.StatusOK)
+		assert.True(t, gjson.GetBytes(actual, "valid").Bool(), "%s", actual)
+		assert.False(t, gjson.GetBytes(actual, "message").Exists(), "%s", actual)
+	})
+
+	t.Run("case=password violates the policy", func(t *testing.T) {
+		actual := validate(t, `{"password":"acme-l3f9toh1"}`, http.StatusOK)
+		assert.False(t, gjson.GetBytes(actual, "valid").Bool(), "%s", actual)
+		assert.EqualValues(t, text.ErrorValidationPasswordDisallowedWord, gjson.GetBytes(actual, "message.id").Int(), "%s", actual)
+	})
+
+	t.Run("case=password is similar to the identifier", func(t *testing.T) {
+		actual := validate(t, `{"password":"foo@bar.com1","identifier":"foo@bar.com"}`, http.StatusOK)
+		assert.EqualValues(t, text.ErrorValidationPasswordIdentifierTooSimilar, gjson.GetBytes(actual, "message.id").Int(), "%s", actual)
+	})
+
+	t.Run("case=password is missing", func(t *testing.T) {
+		_ = validate(t, `{}`, http.StatusBadRequest)
+	})
+}
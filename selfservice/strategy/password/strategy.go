@@ -7,6 +7,7 @@ import (
 	"context"
 	"encoding/json"
 	"strings"
+	"time"
 
 	"github.com/go-playground/validator/v10"
 	"github.com/pkg/errors"
@@ -72,22 +73,35 @@ type registrationStrategyDependencies interface {
 
 	session.HandlerProvider
 	session.ManagementProvider
+
+	LoginAttemptsPersistenceProvider
 }
 
 type Strategy struct {
-	d  registrationStrategyDependencies
-	v  *validator.Validate
-	hd *decoderx.HTTP
+	d       registrationStrategyDependencies
+	v       *validator.Validate
+	hd      *decoderx.HTTP
+	nowFunc func() time.Time
 }
 
 func NewStrategy(d any) *Strategy {
 	return &Strategy{
-		d:  d.(registrationStrategyDependencies),
-		v:  validator.New(),
-		hd: decoderx.NewHTTP(),
+		d:       d.(registrationStrategyDependencies),
+		v:       validator.New(),
+		hd:      decoderx.NewHTTP(),
+		nowFunc: time.Now,
 	}
 }
 
+// SetNowFunc overrides the clock used for the login lockout. Only useful in tests.
+func (s *Strategy) SetNowFunc(t func() time.Time) {
+	s.nowFunc = t
+}
+
+func (s *Strategy) now() time.Time {
+	return s.nowFunc().UTC()
+}
+
 func (s *Strategy) CountActiveFirstFactorCredentials(ctx context.Context, cc map[identity.CredentialsType]identity.Credentials) (count int, err error) {
 	for _, c := range cc {
 		if c.Type == s.ID() && len(c.Config) > 0 {
@@ -0,0 +1,162 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package password
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/kratos/internal/testhelpers"
+	"github.com/ory/kratos/persistence"
+	"github.com/ory/x/randx"
+	"github.com/ory/x/sqlcon"
+)
+
+func TestPersister(ctx context.Context, p interface {
+	persistence.Persister
+},
+) func(t *testing.T) {
+	return func(t *testing.T) {
+		_, p := testhelpers.NewNetworkUnlessExisting(t, ctx, p)
+
+		newIdentifier := func() string {
+			return randx.MustString(16, randx.AlphaLowerNum) + "@ory.sh"
+		}
+		now := time.Now().UTC().Truncate(time.Second)
+
+		t.Run("case=returns not found for unknown identifiers", func(t *testing.T) {
+			_, err := p.GetLoginAttempts(ctx, newIdentifier())
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+		})
+
+		t.Run("case=locks once the threshold is reached", func(t *testing.T) {
+			id := newIdentifier()
+			for i := 1; i < 3; i++ {
+				a, err := p.RecordFailedLoginAttempt(ctx, id, now, 3, time.Minute)
+				require.NoError(t, err)
+				assert.Equal(t, i, a.FailedAttempts)
+				assert.False(t, a.IsLocked(now))
+			}
+
+			a, err := p.RecordFailedLoginAttempt(ctx, id, now, 3, time.Minute)
+			require.NoError(t, err)
+			assert.Equal(t, 3, a.FailedAttempts)
+			assert.True(t, a.IsLocked(now))
+			assert.True(t, a.IsLocked(now.Add(59*time.Second)))
+			assert.False(t, a.IsLocked(now.Add(time.Minute)))
+
+			actual, err := p.GetLoginAttempts(ctx, id)
+			require.NoError(t, err)
+			assert.True(t, actual.IsLocked(now))
+		})
+
+		t.Run("case=identifiers are normalized", func(t *testing.T) {
+			id := newIdentifier()
+			_, err := p.RecordFailedLoginAttempt(ctx, " "+id, now, 3, time.Minute)
+			require.NoError(t, err)
+
+			a, err := p.GetLoginAttempts(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, 1, a.FailedAttempts)
+		})
+
+		t.Run("case=counting restarts after the lockout elapsed", func(t *testing.T) {
+			id := newIdentifier()
+			for i := 0; i < 2; i++ {
+				_, err := p.RecordFailedLoginAttempt(ctx, id, now, 2, time.Minute)
+				require.NoError(t, err)
+			}
+
+			later := now.Add(2 * time.Minute)
+			a, err := p.RecordFailedLoginAttempt(ctx, id, later, 2, time.Minute)
+			require.NoError(t, err)
+			assert.Equal(t, 1, a.FailedAttempts)
+			assert.False(t, a.IsLocked(later))
+		})
+
+		t.Run("case=clear removes the tracker", func(t *testing.T) {
+			id := newIdentifier()
+			_, err := p.RecordFailedLoginAttempt(ctx, id, now, 3, time.Minute)
+			require.NoError(t, err)
+
+			require.NoError(t, p.ClearLoginAttempts(ctx, id))
+			_, err = p.GetLoginAttempts(ctx, id)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+			// Clearing an unknown identifier is not an error.
+			require.NoError(t, p.ClearLoginAttempts(ctx, newIdentifier()))
+		})
+
+		t.Run("case=trackers are isolated per network", func(t *testing.T) {
+			id := newIdentifier()
+			_, other := testhelpers.NewNetwork(t, ctx, p)
+
+			for i := 0; i < 2; i++ {
+				_, err := p.RecordFailedLoginAttempt(ctx, id, now, 2, time.Minute)
+				require.NoError(t, err)
+			}
+
+			_, err := other.GetLoginAttempts(ctx, id)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+			a, err := other.RecordFailedLoginAttempt(ctx, id, now, 2, time.Minute)
+			require.NoError(t, err)
+			assert.Equal(t, 1, a.FailedAttempts)
+			assert.False(t, a.IsLocked(now))
+
+			require.NoError(t, other.ClearLoginAttempts(ctx, id))
+			a, err = p.GetLoginAttempts(ctx, id)
+			require.NoError(t, err)
+			assert.True(t, a.IsLocked(now))
+		})
+
+		t.Run("case=concurrent failures are all counted", func(t *testing.T) {
+			id := newIdentifier()
+			const attempts = 20
+
+			var wg sync.WaitGroup
+			for i := 0; i < attempts; i++ {
+				wg.Add(1)
+				go func() {
+					defer wg.Done()
+					_, err := p.RecordFailedLoginAttempt(ctx, id, now, 5, time.Minute)
+					assert.NoError(t, err)
+				}()
+			}
+			wg.Wait()
+
+			a, err := p.GetLoginAttempts(ctx, id)
+			require.NoError(t, err)
+			assert.Equal(t, attempts, a.FailedAttempts)
+			assert.True(t, a.IsLocked(now))
+		})
+
+		t.Run("case=stale trackers are deleted", func(t *testing.T) {
+			past := now.Add(-2 * time.Hour)
+			stale, locked, recent := newIdentifier(), newIdentifier(), newIdentifier()
+			_, err := p.RecordFailedLoginAttempt(ctx, stale, past, 3, time.Minute)
+			require.NoError(t, err)
+			_, err = p.RecordFailedLoginAttempt(ctx, locked, past, 1, 24*time.Hour)
+			require.NoError(t, err)
+			_, err = p.RecordFailedLoginAttempt(ctx, recent, now, 3, time.Minute)
+			require.NoError(t, err)
+
+			require.NoError(t, p.DeleteStaleLoginAttempts(ctx, now.Add(-time.Hour), 100))
+
+			_, err = p.GetLoginAttempts(ctx, stale)
+			require.ErrorIs(t, err, sqlcon.ErrNoRows)
+
+			// Identifiers which are still locked or were updated recently are kept.
+			_, err = p.GetLoginAttempts(ctx, locked)
+			require.NoError(t, err)
+			_, err = p.GetLoginAttempts(ctx, recent)
+			require.NoError(t, err)
+		})
+	}
+}
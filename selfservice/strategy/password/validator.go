@@ -13,6 +13,7 @@ import (
 	"strconv"
 	"strings"
 	"time"
+	"unicode"
 
 	"go.opentelemetry.io/otel/trace/noop"
 
@@ -121,6 +122,25 @@ func lcsLength(a, b string) int {
 	return greatestLength
 }
 
+// characterClasses counts which of lowercase letters, uppercase letters, digits
+// and symbols occur in password.
+func characterClasses(password string) int {
+	var lower, upper, digit, symbol int
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = 1
+		case unicode.IsUpper(r):
+			upper = 1
+		case unicode.IsDigit(r):
+			digit = 1
+		default:
+			symbol = 1
+		}
+	}
+	return lower + upper + digit + symbol
+}
+
 func (s *DefaultPasswordValidator) fetch(ctx context.Context, hpw []byte, apiDNSName string) (int64, error) {
 	prefix := fmt.Sprintf("%X", hpw)[0:5]
 	loc := fmt.Sprintf("https://%s/range/%s", apiDNSName, prefix)
@@ -184,6 +204,19 @@ func (s *DefaultPasswordValidator) validate(ctx context.Context, identifier, pas
 		return text.NewErrorValidationPasswordMinLength(int(passwordPolicyConfig.MinPasswordLength), len(password))
 	}
 
+	if classes := characterClasses(password); classes < int(passwordPolicyConfig.MinCharacterClasses) {
+		return text.NewErrorValidationPasswordTooFewCharacterClasses(int(passwordPolicyConfig.MinCharacterClasses), classes)
+	}
+
+	if len(passwordPolicyConfig.DisallowedWords) > 0 {
+		compPassword := strings.ToLower(password)
+		for _, word := range passwordPolicyConfig.DisallowedWords {
+			if word != "" && strings.Contains(compPassword, strings.ToLower(word)) {
+				return text.NewErrorValidationPasswordDisallowedWord()
+			}
+		}
+	}
+
 	if passwordPolicyConfig.IdentifierSimilarityCheckEnabled && len(identifier) > 0 {
 		compIdentifier, compPassword := strings.ToLower(identifier), strings.ToLower(password)
 		dist := levenshtein.Distance(compIdentifier, compPassword)
@@ -324,6 +324,45 @@ func TestChangeIdentifierSimilarityCheckEnabled(t *testing.T) {
 	})
 }
 
+func TestChangeMinCharacterClasses(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	s, _ := password.NewDefaultPasswordValidatorStrategy(reg)
+	conf.MustSet(ctx, config.ViperKeyPasswordHaveIBeenPwnedEnabled, false)
+	conf.MustSet(ctx, config.ViperKeyPasswordMinCharacterClasses, 3)
+
+	t.Run("case=should not fail if password has enough character classes", func(t *testing.T) {
+		require.NoError(t, s.Validate(ctx, "", "kuobah7Caas"))
+		require.NoError(t, s.Validate(ctx, "", "kuobah-caas7"))
+	})
+
+	t.Run("case=should fail if password has too few character classes", func(t *testing.T) {
+		err := s.Validate(ctx, "", "kuobahCaas")
+		var msg *text.Message
+		require.ErrorAs(t, err, &msg)
+		assert.Equal(t, text.ErrorValidationPasswordTooFewCharacterClasses, msg.ID)
+	})
+}
+
+func TestChangeDisallowedWords(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	s, _ := password.NewDefaultPasswordValidatorStrategy(reg)
+	conf.MustSet(ctx, config.ViperKeyPasswordHaveIBeenPwnedEnabled, false)
+	conf.MustSet(ctx, config.ViperKeyPasswordDisallowedWords, []string{"acme"})
+
+	t.Run("case=should not fail if password contains no disallowed word", func(t *testing.T) {
+		require.NoError(t, s.Validate(ctx, "", "kuobahcaas"))
+	})
+
+	t.Run("case=should fail if password contains a disallowed word", func(t *testing.T) {
+		err := s.Validate(ctx, "", "kuoACMEbahcaas")
+		var msg *text.Message
+		require.ErrorAs(t, err, &msg)
+		assert.Equal(t, text.ErrorValidationPasswordDisallowedWord, msg.ID)
+	})
+}
+
 type fakeValidatorAPI struct{}
 
 func (api *fakeValidatorAPI) ServeHTTP(w http.ResponseWriter, r *http.Request) {
@@ -25,6 +25,7 @@ import (
 	"github.com/ory/herodot"
 
 	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
 	"github.com/ory/kratos/x"
 )
 
@@ -37,6 +38,7 @@ type (
 		x.LoggingProvider
 		x.CSRFProvider
 		config.Provider
+		identity.PrivilegedPoolProvider
 		sessiontokenexchange.PersistenceProvider
 		TokenizerProvider
 	}
@@ -79,6 +81,7 @@ func (h *Handler) RegisterAdminRoutes(admin *x.RouterAdmin) {
 	admin.GET(AdminRouteIdentitiesSessions, h.listIdentitySessions)
 	admin.DELETE(AdminRouteIdentitiesSessions, h.deleteIdentitySessions)
 	admin.PATCH(AdminRouteSessionExtendId, h.adminSessionExtend)
+	admin.POST(AdminRouteIdentityImpersonations, h.impersonateIdentity)
 
 	admin.DELETE(RouteCollection, x.RedirectToPublicRoute(h.r))
 }
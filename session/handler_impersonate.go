@@ -0,0 +1,147 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package session
+
+import (
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+	"github.com/ory/x/jsonx"
+
+	"github.com/ory/kratos/identity"
+)
+
+const AdminRouteIdentityImpersonations = "/identity-impersonations"
+
+var ErrImpersonatedSession = herodot.ErrForbidden.
+	WithError("session is impersonated").
+	WithReason("This action can not be performed with an impersonated session.")
+
+// Impersonate Identity Request Body
+//
+// swagger:model impersonateIdentityBody
+type impersonateIdentityBody struct {
+	// The ID of the identity to impersonate.
+	//
+	// required: true
+	IdentityID uuid.UUID `json:"identity_id"`
+
+	// The support staff member impersonating the identity. It is recorded on
+	// the session and in the audit log.
+	//
+	// required: true
+	Impersonator string `json:"impersonator"`
+
+	// Why the identity is impersonated.
+	Reason string `json:"reason"`
+}
+
+// Impersonate Identity Parameters
+//
+// swagger:parameters impersonateIdentity
+//
+//nolint:deadcode,unused
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type impersonateIdentity struct {
+	// in: body
+	Body impersonateIdentityBody
+}
+
+// Impersonated Session Response
+//
+// swagger:model impersonateIdentityResponse
+type impersonateIdentityResponse struct {
+	// The Session Token
+	//
+	// Send it in the `X-Session-Token` header to act as the identity.
+	//
+	// required: true
+	Token string `json:"session_token"`
+
+	// The impersonated session
+	//
+	// required: true
+	Session *Session `json:"session"`
+}
+
+// swagger:route POST /admin/identity-impersonations identity impersonateIdentity
+//
+// # Impersonate an Identity
+//
+// Issues a session token for the identity to support staff. The session expires after
+// `session.impersonation.lifespan`, can not be extended, and can not be used to update
+// the identity's settings. The impersonator is returned as `impersonation` by the session
+// endpoints, including `/sessions/whoami`, and is written to the audit log.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Schemes: http, https
+//
+//	Security:
+//	  oryAccessToken:
+//
+//	Responses:
+//	  200: impersonateIdentityResponse
+//	  400: errorGeneric
+//	  404: errorGeneric
+//	  default: errorGeneric
+func (h *Handler) impersonateIdentity(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	ctx := r.Context()
+
+	var body impersonateIdentityBody
+	if err := jsonx.NewStrictDecoder(r.Body).Decode(&body); err != nil {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithError(err.Error())))
+		return
+	}
+	if body.IdentityID.IsNil() {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Field `identity_id` must be set.")))
+		return
+	}
+	body.Impersonator = strings.TrimSpace(body.Impersonator)
+	if body.Impersonator == "" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Field `impersonator` must be set.")))
+		return
+	}
+
+	i, err := h.r.PrivilegedIdentityPool().GetIdentity(ctx, body.IdentityID, identity.ExpandDefault)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	now := time.Now().UTC()
+	s := NewInactiveSession()
+	s.Impersonation = &Impersonation{Impersonator: body.Impersonator, Reason: body.Reason}
+	s.CompletedLoginFor(identity.CredentialsTypeImpersonation, identity.AuthenticatorAssuranceLevel1)
+	if err := h.r.SessionManager().ActivateSession(r, s, i, now); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	s.ExpiresAt = now.Add(h.r.Config().SessionImpersonationLifespan(ctx))
+
+	if err := h.r.SessionPersister().UpsertSession(ctx, s); err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	h.r.Audit().
+		WithRequest(r).
+		WithField("identity_id", i.ID).
+		WithField("session_id", s.ID).
+		WithField("impersonator", body.Impersonator).
+		WithField("reason", body.Reason).
+		Info("An identity is being impersonated.")
+
+	h.r.Writer().Write(w, r, &impersonateIdentityResponse{Token: s.Token, Session: s})
+}
@@ -1095,3 +1095,64 @@ func (s byAuthenticatedAt) Swap(i, j int) { s[i], s[j] = s[j], s[i] }
 func (s byAuthenticatedAt) Less(i, j int) bool {
 	return s[i].AuthenticatedAt.Before(s[j].AuthenticatedAt)
 }
+
+func TestHandlerImpersonateIdentity(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	publicServer, adminServer, _, _ := testhelpers.NewKratosServerWithCSRFAndRouters(t, reg)
+
+	conf.MustSet(ctx, config.ViperKeyPublicBaseURL, "http://example.com")
+	testhelpers.SetDefaultIdentitySchema(conf, "file://./stub/identity.schema.json")
+	conf.MustSet(ctx, config.ViperKeyPublicBaseURL, adminServer.URL)
+	conf.MustSet(ctx, config.ViperKeySessionImpersonationLifespan, "10m")
+
+	i := identity.NewIdentity("")
+	require.NoError(t, reg.IdentityManager().Create(ctx, i))
+
+	impersonate := func(t *testing.T, body string) (*http.Response, []byte) {
+		res, err := adminServer.Client().Post(adminServer.URL+"/admin/identity-impersonations", "application/json", strings.NewReader(body))
+		require.NoError(t, err)
+		defer res.Body.Close()
+		return res, ioutilx.MustReadAll(res.Body)
+	}
+
+	t.Run("case=should issue a flagged session", func(t *testing.T) {
+		res, body := impersonate(t, `{"identity_id":"`+i.ID.String()+`","impersonator":"support@ory.sh","reason":"ticket 123"}`)
+		require.Equal(t, http.StatusOK, res.StatusCode, "%s", body)
+
+		token := gjson.GetBytes(body, "session_token").String()
+		require.NotEmpty(t, token)
+		assert.Equal(t, "support@ory.sh", gjson.GetBytes(body, "session.impersonation.impersonator").String(), "%s", body)
+		assert.Equal(t, "impersonation", gjson.GetBytes(body, "session.authentication_methods.0.method").String(), "%s", body)
+
+		expiresAt := gjson.GetBytes(body, "session.expires_at").Time()
+		assert.WithinDuration(t, time.Now().Add(10*time.Minute), expiresAt, time.Minute)
+
+		req := testhelpers.NewTestHTTPRequest(t, "GET", publicServer.URL+"/sessions/whoami", nil)
+		req.Header.Set("X-Session-Token", token)
+		whoami, err := publicServer.Client().Do(req)
+		require.NoError(t, err)
+		defer whoami.Body.Close()
+		whoamiBody := ioutilx.MustReadAll(whoami.Body)
+		require.Equal(t, http.StatusOK, whoami.StatusCode, "%s", whoamiBody)
+		assert.Equal(t, i.ID.String(), gjson.GetBytes(whoamiBody, "identity.id").String())
+		assert.Equal(t, "ticket 123", gjson.GetBytes(whoamiBody, "impersonation.reason").String(), "%s", whoamiBody)
+
+		sid := uuid.FromStringOrNil(gjson.GetBytes(body, "session.id").String())
+		require.NoError(t, reg.SessionPersister().ExtendSession(ctx, sid))
+		extended, err := reg.SessionPersister().GetSession(ctx, sid, ExpandNothing)
+		require.NoError(t, err)
+		assert.True(t, extended.IsImpersonated())
+		assert.WithinDuration(t, expiresAt, extended.ExpiresAt, time.Second, "impersonated sessions must not be extended")
+	})
+
+	t.Run("case=should require an impersonator", func(t *testing.T) {
+		res, body := impersonate(t, `{"identity_id":"`+i.ID.String()+`","reason":"ticket 123"}`)
+		assert.Equal(t, http.StatusBadRequest, res.StatusCode, "%s", body)
+	})
+
+	t.Run("case=should return 404 for an unknown identity", func(t *testing.T) {
+		res, body := impersonate(t, `{"identity_id":"`+x.NewUUID().String()+`","impersonator":"support@ory.sh"}`)
+		assert.Equal(t, http.StatusNotFound, res.StatusCode, "%s", body)
+	})
+}
@@ -455,7 +455,7 @@ func (s *ManagerHTTP) ActivateSession(r *http.Request, session *Session, i *iden
 	session.ExpiresAt = authenticatedAt.Add(s.r.Config().SessionLifespan(ctx))
 	session.AuthenticatedAt = authenticatedAt
 
-	session.SetSessionDeviceInformation(r.WithContext(ctx))
+	session.SetSessionDeviceInformation(r.WithContext(ctx), x.ClientIP(r, s.r.Config().PublicClientIP(ctx)))
 	session.SetAuthenticatorAssuranceLevel()
 
 	span.SetAttributes(
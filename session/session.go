@@ -14,7 +14,6 @@ import (
 
 	"github.com/ory/kratos/x"
 
-	"github.com/ory/x/httpx"
 	"github.com/ory/x/pagination/keysetpagination"
 	"github.com/ory/x/pointerx"
 
@@ -110,6 +109,12 @@ type Session struct {
 	// A list of authentication methods (e.g. password, oidc, ...) used to issue this session.
 	AMR AuthenticationMethods `db:"authentication_methods" json:"authentication_methods"`
 
+	// Impersonation
+	//
+	// Set if the session was issued to support staff through `POST /admin/identity-impersonations`.
+	// Impersonated sessions can not be extended and can not be used to update the identity's settings.
+	Impersonation *Impersonation `db:"impersonation" json:"impersonation,omitempty" faker:"-"`
+
 	// The Session Issuance Timestamp
 	//
 	// When this session was issued at. Usually equal or close to `authenticated_at`.
@@ -256,10 +261,10 @@ func NewInactiveSession() *Session {
 	}
 }
 
-func (s *Session) SetSessionDeviceInformation(r *http.Request) {
+func (s *Session) SetSessionDeviceInformation(r *http.Request, clientIP string) {
 	device := Device{
 		SessionID: s.ID,
-		IPAddress: pointerx.Ptr(httpx.ClientIP(r)),
+		IPAddress: pointerx.Ptr(clientIP),
 	}
 
 	agent := r.Header["User-Agent"]
@@ -301,9 +306,18 @@ func (s *Session) MarshalJSON() ([]byte, error) {
 }
 
 func (s *Session) CanBeRefreshed(ctx context.Context, c refreshWindowProvider) bool {
+	if s.IsImpersonated() {
+		return false
+	}
 	return s.ExpiresAt.Add(-c.SessionRefreshMinTimeLeft(ctx)).Before(time.Now())
 }
 
+// IsImpersonated returns true if the session was issued to support staff
+// impersonating the identity.
+func (s *Session) IsImpersonated() bool {
+	return s.Impersonation != nil
+}
+
 // List of (Used) AuthenticationMethods
 //
 // A list of authenticators which were used to authenticate the session.
@@ -374,3 +388,39 @@ func (n AuthenticationMethods) Value() (driver.Value, error) {
 	}
 	return string(value), nil
 }
+
+// Session Impersonation
+//
+// Records who impersonated the identity of an impersonated session.
+//
+// swagger:model sessionImpersonation
+type Impersonation struct {
+	// The support staff member who impersonated the identity, as declared by the caller of the admin API.
+	//
+	// required: true
+	Impersonator string `json:"impersonator"`
+
+	// Why the identity was impersonated.
+	Reason string `json:"reason,omitempty"`
+}
+
+// Scan implements the Scanner interface.
+func (n *Impersonation) Scan(value interface{}) error {
+	if value == nil {
+		return nil
+	}
+	v := fmt.Sprintf("%s", value)
+	if len(v) == 0 {
+		return nil
+	}
+	return errors.WithStack(json.Unmarshal([]byte(v), n))
+}
+
+// Value implements the driver Valuer interface.
+func (n Impersonation) Value() (driver.Value, error) {
+	value, err := json.Marshal(n)
+	if err != nil {
+		return nil, errors.WithStack(err)
+	}
+	return string(value), nil
+}
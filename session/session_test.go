@@ -176,6 +176,36 @@ func TestSession(t *testing.T) {
 		assert.Equal(t, "Munich, Germany", *s.Devices[0].Location)
 	})
 
+	t.Run("case=client information from configured header", func(t *testing.T) {
+		conf.MustSet(ctx, config.ViperKeyPublicClientIPHeader, "CF-Connecting-IP")
+		conf.MustSet(ctx, config.ViperKeyPublicClientIPTrustedCIDRs, []string{"10.0.0.0/8"})
+		t.Cleanup(func() {
+			conf.MustSet(ctx, config.ViperKeyPublicClientIPHeader, "")
+			conf.MustSet(ctx, config.ViperKeyPublicClientIPTrustedCIDRs, []string{})
+		})
+
+		for _, tc := range []struct {
+			remote   string
+			expected string
+		}{
+			{remote: "10.1.2.3:4321", expected: "54.155.246.232"},
+			// The header is forged if the request does not come from a trusted proxy.
+			{remote: "203.0.113.7:4321", expected: "203.0.113.7"},
+		} {
+			t.Run("remote="+tc.remote, func(t *testing.T) {
+				req := testhelpers.NewTestHTTPRequest(t, "GET", "/sessions/whoami", nil)
+				req.RemoteAddr = tc.remote
+				req.Header.Set("CF-Connecting-IP", "54.155.246.232")
+				req.Header.Set("True-Client-IP", "54.155.246.155")
+
+				s := session.NewInactiveSession()
+				require.NoError(t, reg.SessionManager().ActivateSession(req, s, &identity.Identity{NID: x.NewUUID(), State: identity.StateActive}, authAt))
+				require.Len(t, s.Devices, 1)
+				assert.Equal(t, tc.expected, *s.Devices[0].IPAddress)
+			})
+		}
+	})
+
 	for k, tc := range []struct {
 		d        string
 		methods  []session.AuthenticationMethod
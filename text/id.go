@@ -153,6 +153,9 @@ const (
 	ErrorValidationTraitsMismatch
 	ErrorValidationAccountNotFound
 	ErrorValidationCaptchaError
+	ErrorValidationAccountLocked
+	ErrorValidationPasswordTooFewCharacterClasses
+	ErrorValidationPasswordDisallowedWord
 )
 
 const (
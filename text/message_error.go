@@ -11,6 +11,7 @@ const (
 	ErrIDSelfServiceFlowDisabled                       = "self_service_flow_disabled"
 	ErrIDSelfServiceBrowserLocationChangeRequiredError = "browser_location_change_required"
 	ErrIDSelfServiceFlowReplaced                       = "self_service_flow_replaced"
+	ErrIDIdentityQuotaExceeded                         = "identity_quota_exceeded"
 
 	ErrIDAlreadyLoggedIn             = "session_already_available"
 	ErrIDAddressNotVerified          = "session_verified_address_required"
@@ -249,6 +249,26 @@ func NewErrorValidationPasswordTooManyBreaches(breaches int64) *Message {
 	}
 }
 
+func NewErrorValidationPasswordTooFewCharacterClasses(minClasses, actualClasses int) *Message {
+	return &Message{
+		ID:   ErrorValidationPasswordTooFewCharacterClasses,
+		Text: fmt.Sprintf("The password must contain at least %d of lowercase letters, uppercase letters, digits and symbols, but got %d.", minClasses, actualClasses),
+		Type: Error,
+		Context: context(map[string]any{
+			"min_classes":    minClasses,
+			"actual_classes": actualClasses,
+		}),
+	}
+}
+
+func NewErrorValidationPasswordDisallowedWord() *Message {
+	return &Message{
+		ID:   ErrorValidationPasswordDisallowedWord,
+		Text: "The password can not be used because it contains a disallowed word.",
+		Type: Error,
+	}
+}
+
 func NewErrorValidationInvalidCredentials() *Message {
 	return &Message{
 		ID:   ErrorValidationInvalidCredentials,
@@ -257,6 +277,14 @@ func NewErrorValidationInvalidCredentials() *Message {
 	}
 }
 
+func NewErrorValidationAccountLocked() *Message {
+	return &Message{
+		ID:   ErrorValidationAccountLocked,
+		Text: "Too many failed login attempts. Please try again later.",
+		Type: Error,
+	}
+}
+
 func NewErrorValidationAccountNotFound() *Message {
 	return &Message{
 		ID:   ErrorValidationAccountNotFound,
@@ -153,6 +153,23 @@ func (c *Container) ResetMessages(exclude ...string) {
 	}
 }
 
+// OverrideMessages replaces the text of the container's own and its node's
+// messages whose ID has an entry in overrides.
+func (c *Container) OverrideMessages(overrides map[text.ID]string) {
+	override := func(messages text.Messages) {
+		for k := range messages {
+			if t, ok := overrides[messages[k].ID]; ok {
+				messages[k].Text = t
+			}
+		}
+	}
+
+	override(c.Messages)
+	for _, n := range c.Nodes {
+		override(n.Messages)
+	}
+}
+
 // Reset resets the container's errors as well as each field's value and errors.
 func (c *Container) Reset(exclude ...string) {
 	c.Messages = nil
@@ -313,6 +313,21 @@ func TestContainer(t *testing.T) {
 		assert.Empty(t, c.Nodes.Find("2").Attributes.(*node.InputAttributes).FieldValue)
 	})
 
+	t.Run("method=OverrideMessages", func(t *testing.T) {
+		c := Container{
+			Nodes: node.Nodes{
+				&node.Node{Messages: text.Messages{{ID: 1, Text: "foo"}, {ID: 2, Text: "bar"}}, Group: node.DefaultGroup, Type: node.Input, Attributes: &node.InputAttributes{Name: "1", Type: node.InputAttributeTypeText}},
+			},
+			Messages: text.Messages{{ID: 1, Text: "foo"}, {ID: 3, Text: "baz"}},
+		}
+		c.OverrideMessages(map[text.ID]string{1: "custom"})
+
+		assert.Equal(t, "custom", c.Messages[0].Text)
+		assert.Equal(t, "baz", c.Messages[1].Text)
+		assert.Equal(t, "custom", c.Nodes.Find("1").Messages[0].Text)
+		assert.Equal(t, "bar", c.Nodes.Find("1").Messages[1].Text)
+	})
+
 	t.Run("method=remove", func(t *testing.T) {
 		c := Container{
 			Nodes: node.Nodes{
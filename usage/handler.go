@@ -0,0 +1,156 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"encoding/csv"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/julienschmidt/httprouter"
+	"github.com/pkg/errors"
+
+	"github.com/ory/herodot"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/x"
+)
+
+const RouteUsage = "/usage"
+
+type (
+	handlerDependencies interface {
+		x.WriterProvider
+		x.CSRFProvider
+		PersistenceProvider
+		config.Provider
+	}
+	Handler struct {
+		r handlerDependencies
+	}
+	HandlerProvider interface {
+		UsageHandler() *Handler
+	}
+)
+
+func NewHandler(r handlerDependencies) *Handler {
+	return &Handler{r: r}
+}
+
+func (h *Handler) RegisterPublicRoutes(public *x.RouterPublic) {
+	h.r.CSRFHandler().IgnoreGlobs(x.AdminPrefix+RouteUsage, RouteUsage)
+	public.GET(x.AdminPrefix+RouteUsage, x.RedirectToAdminRoute(h.r))
+}
+
+func (h *Handler) RegisterAdminRoutes(admin *x.RouterAdmin) {
+	admin.GET(RouteUsage, h.getUsageReport)
+}
+
+// Get Usage Report Parameters
+//
+// swagger:parameters getUsageReport
+//
+//nolint:deadcode,unused
+//lint:ignore U1000 Used to generate Swagger and OpenAPI definitions
+type getUsageReport struct {
+	// The inclusive start of the window in RFC 3339 format.
+	//
+	// required: true
+	// in: query
+	From time.Time `json:"from"`
+
+	// The exclusive end of the window in RFC 3339 format. The window must
+	// not exceed 92 days.
+	//
+	// required: true
+	// in: query
+	To time.Time `json:"to"`
+
+	// The response format, either `json` (default) or `csv`.
+	//
+	// in: query
+	Format string `json:"format"`
+}
+
+// swagger:route GET /admin/usage usage getUsageReport
+//
+// # Get a Usage Report
+//
+// Aggregates active identities, authenticated identities, issued sessions,
+// and sent messages within a time window, for example for billing exports.
+//
+//	Produces:
+//	- application/json
+//	- text/csv
+//
+//	Security:
+//	  oryAccessToken:
+//
+//	Schemes: http, https
+//
+//	Responses:
+//	  200: usageReport
+//	  400: errorGeneric
+//	  default: errorGeneric
+func (h *Handler) getUsageReport(w http.ResponseWriter, r *http.Request, _ httprouter.Params) {
+	from, err := parseTime(r, "from")
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	to, err := parseTime(r, "to")
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+	if !to.After(from) {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Query parameter to must be after from.")))
+		return
+	} else if to.Sub(from) > MaxWindow {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReasonf("The window must not exceed %d days.", int(MaxWindow.Hours()/24))))
+		return
+	}
+
+	format := r.URL.Query().Get("format")
+	if format != "" && format != "json" && format != "csv" {
+		h.r.Writer().WriteError(w, r, errors.WithStack(herodot.ErrBadRequest.WithReason("Query parameter format must be json or csv.")))
+		return
+	}
+
+	report, err := h.r.UsagePersister().UsageReport(r.Context(), from, to)
+	if err != nil {
+		h.r.Writer().WriteError(w, r, err)
+		return
+	}
+
+	if format == "csv" {
+		w.Header().Set("Content-Type", "text/csv")
+		w.Header().Set("Content-Disposition", `attachment; filename="usage.csv"`)
+		cw := csv.NewWriter(w)
+		_ = cw.WriteAll([][]string{
+			{"from", "to", "active_identities", "authenticated_identities", "sessions_issued", "emails_sent", "sms_sent"},
+			{
+				report.From.Format(time.RFC3339),
+				report.To.Format(time.RFC3339),
+				strconv.FormatInt(report.ActiveIdentities, 10),
+				strconv.FormatInt(report.AuthenticatedIdentities, 10),
+				strconv.FormatInt(report.SessionsIssued, 10),
+				strconv.FormatInt(report.EmailsSent, 10),
+				strconv.FormatInt(report.SMSSent, 10),
+			},
+		})
+		return
+	}
+
+	h.r.Writer().Write(w, r, report)
+}
+
+func parseTime(r *http.Request, key string) (time.Time, error) {
+	t, err := time.Parse(time.RFC3339, r.URL.Query().Get(key))
+	if err != nil {
+		return time.Time{}, errors.WithStack(herodot.ErrBadRequest.WithReasonf("Query parameter %s must be a time in RFC 3339 format.", key))
+	}
+	return t.UTC(), nil
+}
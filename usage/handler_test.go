@@ -0,0 +1,138 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package usage_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+
+	"github.com/ory/kratos/courier"
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/identity"
+	"github.com/ory/kratos/internal"
+	"github.com/ory/kratos/internal/testhelpers"
+	"github.com/ory/kratos/session"
+	"github.com/ory/kratos/usage"
+	"github.com/ory/kratos/x"
+)
+
+func TestHandler(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+	testhelpers.SetDefaultIdentitySchemaFromRaw(conf, []byte(`{"type": "object"}`))
+	_, adminTS := testhelpers.NewKratosServer(t, reg)
+	conf.MustSet(ctx, config.ViperKeyAdminBaseURL, adminTS.URL)
+
+	now := time.Now().UTC()
+	before := now.Add(-48 * time.Hour)
+
+	var identities []*identity.Identity
+	for _, state := range []identity.State{identity.StateActive, identity.StateActive, identity.StateActive, identity.StateInactive} {
+		i := identity.NewIdentity(config.DefaultIdentityTraitsSchemaID)
+		i.State = state
+		require.NoError(t, reg.PrivilegedIdentityPool().CreateIdentity(ctx, i))
+		identities = append(identities, i)
+	}
+
+	for _, s := range []struct {
+		identity  *identity.Identity
+		createdAt time.Time
+	}{
+		{identities[0], now},
+		{identities[0], now},
+		{identities[1], now},
+		{identities[2], before},
+	} {
+		sess := session.NewInactiveSession()
+		sess.IdentityID = s.identity.ID
+		sess.CreatedAt = s.createdAt
+		sess.IssuedAt = s.createdAt
+		sess.ExpiresAt = s.createdAt.Add(time.Hour)
+		sess.AuthenticatedAt = s.createdAt
+		require.NoError(t, reg.SessionPersister().UpsertSession(ctx, sess))
+	}
+
+	for _, m := range []struct {
+		messageType courier.MessageType
+		status      courier.MessageStatus
+		createdAt   time.Time
+	}{
+		{courier.MessageTypeEmail, courier.MessageStatusSent, now},
+		{courier.MessageTypeEmail, courier.MessageStatusSent, now},
+		{courier.MessageTypeEmail, courier.MessageStatusQueued, now},
+		{courier.MessageTypeEmail, courier.MessageStatusSent, before},
+		{courier.MessageTypeSMS, courier.MessageStatusSent, now},
+		{courier.MessageTypeSMS, courier.MessageStatusAbandoned, now},
+	} {
+		msg := &courier.Message{
+			Type:         m.messageType,
+			Recipient:    "recipient@ory.sh",
+			Subject:      "subject",
+			Body:         "body",
+			TemplateType: "stub",
+			CreatedAt:    m.createdAt,
+		}
+		require.NoError(t, reg.CourierPersister().AddMessage(ctx, msg))
+		require.NoError(t, reg.CourierPersister().SetMessageStatus(ctx, msg.ID, m.status))
+	}
+
+	get := func(t *testing.T, from, to time.Time, format string, expectCode int) []byte {
+		t.Helper()
+		res, err := adminTS.Client().Get(adminTS.URL + x.AdminPrefix + usage.RouteUsage + "?" + url.Values{
+			"from":   {from.Format(time.RFC3339)},
+			"to":     {to.Format(time.RFC3339)},
+			"format": {format},
+		}.Encode())
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		require.Equal(t, expectCode, res.StatusCode, "%s", body)
+		return body
+	}
+
+	from, to := now.Add(-time.Hour), now.Add(time.Hour)
+
+	t.Run("format=json", func(t *testing.T) {
+		body := get(t, from, to, "", http.StatusOK)
+		assert.EqualValues(t, 3, gjson.GetBytes(body, "active_identities").Int(), "%s", body)
+		assert.EqualValues(t, 2, gjson.GetBytes(body, "authenticated_identities").Int(), "%s", body)
+		assert.EqualValues(t, 3, gjson.GetBytes(body, "sessions_issued").Int(), "%s", body)
+		assert.EqualValues(t, 2, gjson.GetBytes(body, "emails_sent").Int(), "%s", body)
+		assert.EqualValues(t, 1, gjson.GetBytes(body, "sms_sent").Int(), "%s", body)
+	})
+
+	t.Run("format=csv", func(t *testing.T) {
+		records, err := csv.NewReader(bytes.NewReader(get(t, from.Add(-72*time.Hour), to, "csv", http.StatusOK))).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+		assert.Equal(t, []string{"from", "to", "active_identities", "authenticated_identities", "sessions_issued", "emails_sent", "sms_sent"}, records[0])
+		assert.Equal(t, []string{"3", "3", "4", "3", "1"}, records[1][2:])
+	})
+
+	t.Run("case=rejects invalid windows", func(t *testing.T) {
+		for name, tc := range map[string]struct {
+			from, to time.Time
+			format   string
+		}{
+			"to before from":  {from: to, to: from},
+			"window too long": {from: now.Add(-usage.MaxWindow - time.Hour), to: now},
+			"unknown format":  {from: from, to: to, format: "xml"},
+		} {
+			t.Run("case="+name, func(t *testing.T) {
+				get(t, tc.from, tc.to, tc.format, http.StatusBadRequest)
+			})
+		}
+	})
+}
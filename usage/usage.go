@@ -0,0 +1,70 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package usage
+
+import (
+	"context"
+	"time"
+)
+
+// MaxWindow is the longest time window a usage report may cover.
+const MaxWindow = 92 * 24 * time.Hour
+
+type (
+	// Usage Report
+	//
+	// Aggregated usage of the project within a time window.
+	//
+	// swagger:model usageReport
+	Report struct {
+		// From is the inclusive start of the window.
+		//
+		// required: true
+		From time.Time `json:"from"`
+
+		// To is the exclusive end of the window.
+		//
+		// required: true
+		To time.Time `json:"to"`
+
+		// ActiveIdentities is the number of identities in the active state
+		// which were created before the end of the window.
+		//
+		// required: true
+		ActiveIdentities int64 `json:"active_identities"`
+
+		// AuthenticatedIdentities is the number of distinct identities which
+		// were issued at least one session within the window.
+		//
+		// required: true
+		AuthenticatedIdentities int64 `json:"authenticated_identities"`
+
+		// SessionsIssued is the number of sessions issued within the window.
+		//
+		// required: true
+		SessionsIssued int64 `json:"sessions_issued"`
+
+		// EmailsSent is the number of emails queued within the window which
+		// were sent successfully.
+		//
+		// required: true
+		EmailsSent int64 `json:"emails_sent"`
+
+		// SMSSent is the number of SMS queued within the window which were
+		// sent successfully.
+		//
+		// required: true
+		SMSSent int64 `json:"sms_sent"`
+	}
+
+	Persister interface {
+		// UsageReport aggregates the usage of the current network within
+		// [from, to).
+		UsageReport(ctx context.Context, from, to time.Time) (*Report, error)
+	}
+
+	PersistenceProvider interface {
+		UsagePersister() Persister
+	}
+)
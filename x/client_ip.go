@@ -0,0 +1,63 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net"
+	"net/http"
+	"strings"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/x/httpx"
+)
+
+// ClientIP derives the client IP of the request.
+//
+// If no client IP header is configured, the well-known proxy headers are used
+// as before. Otherwise, the header is only evaluated if the request comes from a
+// trusted proxy. Forged or malformed values fall back to the socket address.
+func ClientIP(r *http.Request, conf *config.ClientIP) string {
+	if conf.Header == "" {
+		return httpx.ClientIP(r)
+	}
+
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+
+	if !isTrustedProxy(remote, conf.TrustedCIDRs) {
+		return remote
+	}
+
+	value := r.Header.Get(conf.Header)
+	if strings.EqualFold(conf.Header, "X-Forwarded-For") {
+		// Each trusted proxy appends the address it received the request from, so
+		// everything left of the last `TrustedHops` entries may be forged.
+		hops := strings.Split(value, ",")
+		if conf.TrustedHops < 1 || len(hops) < conf.TrustedHops {
+			return remote
+		}
+		value = hops[len(hops)-conf.TrustedHops]
+	}
+
+	ip := net.ParseIP(strings.TrimSpace(value))
+	if ip == nil {
+		return remote
+	}
+	return ip.String()
+}
+
+func isTrustedProxy(addr string, trusted []*net.IPNet) bool {
+	ip := net.ParseIP(addr)
+	if ip == nil {
+		return false
+	}
+	for _, cidr := range trusted {
+		if cidr.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
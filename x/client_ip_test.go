@@ -0,0 +1,85 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/ory/kratos/driver/config"
+)
+
+func TestClientIP(t *testing.T) {
+	_, trusted, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+
+	for _, tc := range []struct {
+		d        string
+		conf     config.ClientIP
+		remote   string
+		headers  map[string]string
+		expected string
+	}{
+		{
+			d:        "falls back to the proxy headers if no header is configured",
+			remote:   "203.0.113.7:1234",
+			headers:  map[string]string{"True-Client-IP": "54.155.246.155"},
+			expected: "54.155.246.155",
+		},
+		{
+			d:        "uses the configured header from a trusted proxy",
+			conf:     config.ClientIP{Header: "CF-Connecting-IP", TrustedHops: 1, TrustedCIDRs: []*net.IPNet{trusted}},
+			remote:   "10.0.0.1:1234",
+			headers:  map[string]string{"CF-Connecting-IP": "54.155.246.155", "True-Client-IP": "1.1.1.1"},
+			expected: "54.155.246.155",
+		},
+		{
+			d:        "ignores the header from an untrusted hop",
+			conf:     config.ClientIP{Header: "CF-Connecting-IP", TrustedHops: 1, TrustedCIDRs: []*net.IPNet{trusted}},
+			remote:   "203.0.113.7:1234",
+			headers:  map[string]string{"CF-Connecting-IP": "54.155.246.155"},
+			expected: "203.0.113.7",
+		},
+		{
+			d:        "ignores malformed header values",
+			conf:     config.ClientIP{Header: "CF-Connecting-IP", TrustedHops: 1, TrustedCIDRs: []*net.IPNet{trusted}},
+			remote:   "10.0.0.1:1234",
+			headers:  map[string]string{"CF-Connecting-IP": "not-an-ip"},
+			expected: "10.0.0.1",
+		},
+		{
+			d:        "skips forged X-Forwarded-For entries",
+			conf:     config.ClientIP{Header: "X-Forwarded-For", TrustedHops: 2, TrustedCIDRs: []*net.IPNet{trusted}},
+			remote:   "10.0.0.2:1234",
+			headers:  map[string]string{"X-Forwarded-For": "6.6.6.6, 54.155.246.155, 10.0.0.1"},
+			expected: "54.155.246.155",
+		},
+		{
+			d:        "falls back to the socket if X-Forwarded-For has too few hops",
+			conf:     config.ClientIP{Header: "X-Forwarded-For", TrustedHops: 2, TrustedCIDRs: []*net.IPNet{trusted}},
+			remote:   "10.0.0.2:1234",
+			headers:  map[string]string{"X-Forwarded-For": "54.155.246.155"},
+			expected: "10.0.0.2",
+		},
+		{
+			d:        "does not trust anyone without trusted networks",
+			conf:     config.ClientIP{Header: "X-Forwarded-For", TrustedHops: 1},
+			remote:   "10.0.0.2:1234",
+			headers:  map[string]string{"X-Forwarded-For": "54.155.246.155"},
+			expected: "10.0.0.2",
+		},
+	} {
+		t.Run("case="+tc.d, func(t *testing.T) {
+			r := &http.Request{RemoteAddr: tc.remote, Header: http.Header{}}
+			for k, v := range tc.headers {
+				r.Header.Set(k, v)
+			}
+			assert.Equal(t, tc.expected, ClientIP(r, &tc.conf))
+		})
+	}
+}
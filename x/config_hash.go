@@ -0,0 +1,39 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/urfave/negroni"
+
+	"github.com/ory/kratos/driver/config"
+)
+
+const HeaderConfigHash = "X-Kratos-Config-Hash"
+
+// ConfigHashMiddleware adds the hash of the effective configuration to
+// self-service responses if `serve.public.expose_config_hash` is enabled.
+// Clients can use it to invalidate cached forms when the configuration changes.
+func ConfigHashMiddleware(reg interface {
+	config.Provider
+	LoggingProvider
+}) negroni.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		ctx := r.Context()
+		if !strings.HasPrefix(r.URL.Path, "/self-service/") || !reg.Config().PublicExposeConfigHash(ctx) {
+			next(w, r)
+			return
+		}
+
+		hash, err := reg.Config().ConfigContentHash(ctx)
+		if err != nil {
+			reg.Logger().WithError(err).Warn("Unable to compute the configuration hash.")
+		} else {
+			w.Header().Set(HeaderConfigHash, hash)
+		}
+		next(w, r)
+	}
+}
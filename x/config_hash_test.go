@@ -0,0 +1,52 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/urfave/negroni"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/internal"
+	"github.com/ory/kratos/x"
+)
+
+func TestConfigHashMiddleware(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+
+	n := negroni.New()
+	n.UseFunc(x.ConfigHashMiddleware(reg))
+	n.UseHandlerFunc(func(w http.ResponseWriter, r *http.Request) {})
+	ts := httptest.NewServer(n)
+	t.Cleanup(ts.Close)
+
+	hashOf := func(t *testing.T, path string) string {
+		res, err := ts.Client().Get(ts.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		return res.Header.Get(x.HeaderConfigHash)
+	}
+
+	assert.Empty(t, hashOf(t, "/self-service/login/browser"), "the header is disabled by default")
+
+	conf.MustSet(ctx, config.ViperKeyPublicExposeConfigHash, true)
+	first := hashOf(t, "/self-service/login/browser")
+	assert.Len(t, first, 16)
+	assert.Equal(t, first, hashOf(t, "/self-service/registration/browser"), "the hash is stable while the config is unchanged")
+	assert.Empty(t, hashOf(t, "/sessions/whoami"), "only self-service endpoints carry the header")
+
+	expected, err := conf.ConfigContentHash(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, expected, first)
+
+	conf.MustSet(ctx, config.ViperKeyPasswordMinLength, 12)
+	assert.NotEqual(t, first, hashOf(t, "/self-service/login/browser"), "the hash changes with the config")
+}
@@ -0,0 +1,51 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+
+	"github.com/ory/kratos/driver/config"
+)
+
+// ErrorOptions returns the `errors.id_prefix` for the request and whether
+// `errors.include_debug` requires stripping the `reason` and `debug` fields
+// from its error payloads. Responses of the admin API are never stripped.
+func ErrorOptions(c *config.Config, r *http.Request) (idPrefix string, strip bool) {
+	ctx := r.Context()
+	return c.ErrorsIDPrefix(ctx), !c.ErrorsIncludeDebug(ctx) && !strings.HasPrefix(r.URL.Path, AdminPrefix)
+}
+
+// ApplyErrorOptions prefixes the ID of the JSON encoded error at path and, if
+// strip is set, removes its `reason` and `debug` fields. An empty path refers
+// to the document itself.
+func ApplyErrorOptions(raw []byte, path, idPrefix string, strip bool) (_ []byte, err error) {
+	field := func(name string) string {
+		if path == "" {
+			return name
+		}
+		return path + "." + name
+	}
+
+	if id := gjson.GetBytes(raw, field("id")); idPrefix != "" && id.Type == gjson.String && !strings.HasPrefix(id.Str, idPrefix) {
+		if raw, err = sjson.SetBytes(raw, field("id"), idPrefix+id.Str); err != nil {
+			return nil, errors.WithStack(err)
+		}
+	}
+
+	if strip {
+		for _, name := range []string{"reason", "debug"} {
+			if raw, err = sjson.DeleteBytes(raw, field(name)); err != nil {
+				return nil, errors.WithStack(err)
+			}
+		}
+	}
+
+	return raw, nil
+}
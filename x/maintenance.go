@@ -0,0 +1,58 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/urfave/negroni"
+
+	"github.com/ory/herodot"
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/x/healthx"
+	prometheus "github.com/ory/x/prometheusx"
+)
+
+var ErrMaintenance = herodot.DefaultError{
+	CodeField:   http.StatusServiceUnavailable,
+	StatusField: http.StatusText(http.StatusServiceUnavailable),
+	ErrorField:  "The service is temporarily unavailable due to maintenance.",
+}
+
+// MaintenanceModeMiddleware rejects requests with 503 Service Unavailable while
+// maintenance mode is enabled. Health checks, the version and metrics endpoints
+// are always served. The whoami path is served if `maintenance.allow_whoami` is set.
+//
+// The config is resolved per request, so toggling maintenance mode takes effect
+// without a restart.
+func MaintenanceModeMiddleware(reg interface {
+	config.Provider
+	WriterProvider
+}, whoamiPath string,
+) negroni.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, next http.HandlerFunc) {
+		conf := reg.Config().MaintenanceConfig(r.Context())
+		if !conf.Enabled {
+			next(w, r)
+			return
+		}
+
+		switch r.URL.Path {
+		case healthx.AliveCheckPath, healthx.ReadyCheckPath, healthx.VersionPath, prometheus.MetricsPrometheusPath:
+			next(w, r)
+			return
+		case whoamiPath:
+			if conf.AllowWhoami {
+				next(w, r)
+				return
+			}
+		}
+
+		if conf.RetryAfterSeconds > 0 {
+			w.Header().Set("Retry-After", strconv.Itoa(conf.RetryAfterSeconds))
+		}
+		reg.Writer().WriteError(w, r, ErrMaintenance.WithReason(conf.Message))
+	}
+}
@@ -0,0 +1,88 @@
+// Copyright © 2024 Ory Corp
+// SPDX-License-Identifier: Apache-2.0
+
+package x_test
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tidwall/gjson"
+	"github.com/urfave/negroni"
+
+	"github.com/ory/kratos/driver/config"
+	"github.com/ory/kratos/internal"
+	"github.com/ory/kratos/x"
+	"github.com/ory/x/healthx"
+)
+
+func TestMaintenanceModeMiddleware(t *testing.T) {
+	ctx := context.Background()
+	conf, reg := internal.NewFastRegistryWithMocks(t)
+
+	n := negroni.New()
+	n.UseFunc(x.MaintenanceModeMiddleware(reg, "/sessions/whoami"))
+	n.UseHandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte("ok"))
+	})
+	ts := httptest.NewServer(n)
+	t.Cleanup(ts.Close)
+
+	get := func(t *testing.T, path string) (*http.Response, string) {
+		res, err := ts.Client().Get(ts.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, string(body)
+	}
+
+	t.Run("case=passes requests through if disabled", func(t *testing.T) {
+		res, body := get(t, "/self-service/login/browser")
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Equal(t, "ok", body)
+	})
+
+	t.Run("case=rejects requests if enabled", func(t *testing.T) {
+		conf.MustSet(ctx, config.ViperKeyMaintenanceEnabled, true)
+		conf.MustSet(ctx, config.ViperKeyMaintenanceMessage, "back soon")
+		conf.MustSet(ctx, config.ViperKeyMaintenanceRetryAfterSeconds, 120)
+		t.Cleanup(func() {
+			conf.MustSet(ctx, config.ViperKeyMaintenanceEnabled, false)
+			conf.MustSet(ctx, config.ViperKeyMaintenanceMessage, "")
+			conf.MustSet(ctx, config.ViperKeyMaintenanceRetryAfterSeconds, 0)
+		})
+
+		for _, path := range []string{"/self-service/login/browser", "/sessions/whoami"} {
+			res, body := get(t, path)
+			assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode, path)
+			assert.Equal(t, "120", res.Header.Get("Retry-After"), path)
+			assert.Equal(t, "back soon", gjson.Get(body, "error.reason").String(), "%s", body)
+		}
+
+		res, _ := get(t, healthx.AliveCheckPath)
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+
+		t.Run("case=allows whoami if configured", func(t *testing.T) {
+			conf.MustSet(ctx, config.ViperKeyMaintenanceAllowWhoami, true)
+			t.Cleanup(func() { conf.MustSet(ctx, config.ViperKeyMaintenanceAllowWhoami, false) })
+
+			res, _ := get(t, "/sessions/whoami")
+			assert.Equal(t, http.StatusOK, res.StatusCode)
+
+			res, _ = get(t, "/self-service/login/browser")
+			assert.Equal(t, http.StatusServiceUnavailable, res.StatusCode)
+		})
+	})
+
+	t.Run("case=passes requests through again once disabled", func(t *testing.T) {
+		res, _ := get(t, "/self-service/login/browser")
+		assert.Equal(t, http.StatusOK, res.StatusCode)
+		assert.Empty(t, res.Header.Get("Retry-After"))
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/ory/kratos/selfservice/flow/verification"
 	"github.com/ory/kratos/selfservice/strategy/code"
 	"github.com/ory/kratos/selfservice/strategy/link"
+	"github.com/ory/kratos/selfservice/strategy/password"
 	"github.com/ory/kratos/session"
 )
 
@@ -39,6 +40,7 @@ func CleanSQL(t testing.TB, c *pop.Connection) {
 		new(login.Flow).TableName(ctx),
 		new(registration.Flow).TableName(ctx),
 		new(settings.Flow).TableName(ctx),
+		new(password.LoginAttempts).TableName(ctx),
 
 		new(link.RecoveryToken).TableName(ctx),
 		new(link.VerificationToken).TableName(ctx),
@@ -51,6 +53,7 @@ func CleanSQL(t testing.TB, c *pop.Connection) {
 
 		new(errorx.ErrorContainer).TableName(ctx),
 
+		new(identity.WebHookDelivery).TableName(ctx),
 		new(identity.CredentialIdentifier).TableName(ctx),
 		new(identity.Credentials).TableName(ctx),
 		new(identity.VerifiableAddress).TableName(ctx),